@@ -34,6 +34,14 @@ type CacheClusterSpec struct {
 	// If the AZMode and PreferredAvailabilityZones are not specified, ElastiCache
 	// assumes single-az mode.
 	AZMode *string `json:"azMode,omitempty"`
+	// If true, the modifications are applied, asynchronously and as soon as possible,
+	// regardless of the PreferredMaintenanceWindow setting for the cluster.
+	// If false, changes that support it, such as CacheNodeType and EngineVersion
+	// changes, are deferred to the next PreferredMaintenanceWindow. Deferred changes
+	// are reported in Status.PendingModifiedValues until they are applied.
+	//
+	// Default: true
+	ApplyImmediately *bool `json:"applyImmediately,omitempty"`
 	// Reserved parameter. The password used to access a password protected server.
 	//
 	// Password constraints:
@@ -140,6 +148,17 @@ type CacheClusterSpec struct {
 	// see Subnets and Subnet Groups (https://docs.aws.amazon.com/AmazonElastiCache/latest/dg/SubnetGroups.html).
 	CacheSubnetGroupName *string                                  `json:"cacheSubnetGroupName,omitempty"`
 	CacheSubnetGroupRef  *ackv1alpha1.AWSResourceReferenceWrapper `json:"cacheSubnetGroupRef,omitempty"`
+	// Publishes the endpoint of the cluster into a Secret. For Memcached
+	// clusters this is the configuration endpoint, otherwise the endpoint of
+	// the cache node.
+	ConnectionSecret *ConnectionSecret `json:"connectionSecret,omitempty"`
+	// When true, a final snapshot is taken when the cache cluster is deleted. If
+	// FinalSnapshotIdentifier is not set, the snapshot is named after the cache
+	// cluster and the time it was deleted, for example
+	// my-cache-cluster-final-20260102-150405.
+	//
+	// Final snapshots are only supported by Valkey and Redis OSS cache clusters.
+	CreateFinalSnapshot *bool `json:"createFinalSnapshot,omitempty"`
 	// The name of the cache engine to be used for this cluster.
 	//
 	// Valid values for this parameter are: memcached | redis
@@ -154,6 +173,10 @@ type CacheClusterSpec struct {
 	// an earlier engine version, you must delete the existing cluster or replication
 	// group and create it anew with the earlier engine version.
 	EngineVersion *string `json:"engineVersion,omitempty"`
+	// The user-supplied name of a final cluster snapshot. This is the unique name
+	// that identifies the snapshot. ElastiCache creates the snapshot, and then
+	// deletes the cluster immediately afterward.
+	FinalSnapshotIdentifier *string `json:"finalSnapshotIdentifier,omitempty"`
 	// The network type you choose when modifying a cluster, either ipv4 | ipv6.
 	// IPv6 is supported for workloads using Valkey 7.2 and above, Redis OSS engine
 	// version 6.2 to 7.1 and Memcached engine version 1.6.6 and above on all instances
@@ -237,6 +260,16 @@ type CacheClusterSpec struct {
 	// Private Cloud (Amazon VPC).
 	SecurityGroupIDs  []*string                                  `json:"securityGroupIDs,omitempty"`
 	SecurityGroupRefs []*ackv1alpha1.AWSResourceReferenceWrapper `json:"securityGroupRefs,omitempty"`
+	// The name of a self-service update to apply to this cluster. The update
+	// is applied once it shows up in Status.PendingUpdateActions, during
+	// ServiceUpdateWindow when one is set.
+	ServiceUpdateName *string `json:"serviceUpdateName,omitempty"`
+	// The weekly time range (in UTC) during which ServiceUpdateName may be applied,
+	// in the format ddd:hh24:mi-ddd:hh24:mi. An update that is still running when
+	// the window closes is stopped and resumed during the next window.
+	//
+	// Example: sun:23:00-mon:01:30
+	ServiceUpdateWindow *string `json:"serviceUpdateWindow,omitempty"`
 	// A single-element string list containing an Amazon Resource Name (ARN) that
 	// uniquely identifies a Valkey or Redis OSS RDB snapshot file stored in Amazon
 	// S3. The snapshot file is used to populate the node group (shard). The Amazon
@@ -347,6 +380,10 @@ type CacheClusterStatus struct {
 	NotificationConfiguration *NotificationConfiguration `json:"notificationConfiguration,omitempty"`
 	// +kubebuilder:validation:Optional
 	PendingModifiedValues *PendingModifiedValues `json:"pendingModifiedValues,omitempty"`
+	// The self-service updates that are available for this cluster and have
+	// not been applied yet.
+	// +kubebuilder:validation:Optional
+	PendingUpdateActions []*UpdateAction `json:"pendingUpdateActions,omitempty"`
 	// A boolean value indicating whether log delivery is enabled for the replication
 	// group.
 	// +kubebuilder:validation:Optional
@@ -0,0 +1,35 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+// ConnectionSecret configures a Secret, in the namespace of a cache resource,
+// that the controller writes the connection details of the cache to and
+// keeps current as endpoints change, for example after a failover. The
+// Secret is owned by the cache resource and deleted along with it.
+//
+// The Secret has the following keys, each only present when it applies to
+// the cache:
+//
+//   - host: the address clients connect to
+//   - port: the port clients connect to
+//   - tls: "true" if in-transit encryption is enabled, "false" otherwise
+//   - readerHost: the address of the reader endpoint
+//   - readerPort: the port of the reader endpoint
+//   - authToken: the AUTH token, copied from the Secret referenced by
+//     Spec.AuthToken
+type ConnectionSecret struct {
+	// The name of the Secret.
+	// +kubebuilder:validation:Required
+	Name *string `json:"name"`
+}
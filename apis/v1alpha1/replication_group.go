@@ -25,7 +25,25 @@ import (
 // Contains all of the attributes of a specific Valkey or Redis OSS replication
 // group.
 type ReplicationGroupSpec struct {
-
+	// When true, the final snapshot taken on deletion of the replication group
+	// is adopted as a Snapshot resource in the namespace of this replication
+	// group, so that it outlives the replication group and can later be used to
+	// restore it. The Snapshot resource retains the snapshot when it is
+	// deleted. The replication group is not removed until the adoption
+	// succeeds.
+	AdoptFinalSnapshot *bool `json:"adoptFinalSnapshot,omitempty"`
+	// If true, the modifications are applied, asynchronously and as soon as possible,
+	// regardless of the PreferredMaintenanceWindow setting for the replication group.
+	// If false, changes that support it, such as CacheNodeType and EngineVersion
+	// changes, are deferred to the next PreferredMaintenanceWindow. Deferred changes
+	// are reported by the member clusters until they are applied.
+	//
+	// AuthToken, TransitEncryptionEnabled, TransitEncryptionMode and ClusterMode
+	// changes are always applied immediately, because they are applied in steps
+	// that each wait for the previous one to complete.
+	//
+	// Default: true
+	ApplyImmediately *bool `json:"applyImmediately,omitempty"`
 	// A flag that enables encryption at-rest on the replication group when set
 	// to true. In some cases, encryption at-rest may be enabled even when this
 	// value is false. Use StorageEncryptionType to view the effective encryption
@@ -50,6 +68,22 @@ type ReplicationGroupSpec struct {
 	//
 	//   - Must be at least 16 characters and no more than 128 characters in length.
 	AuthToken *ackv1alpha1.SecretKeyReference `json:"authToken,omitempty"`
+	// Specifies the strategy to use when the value of the AuthToken secret changes.
+	// Possible values:
+	//
+	//   - ROTATE - default, the new AUTH token is added while the previous one
+	//     keeps working. Once Status.AuthTokenLastModifiedDate is older than
+	//     the --auth-token-rotation-grace-period of the controller, an hour by
+	//     default, and no other change is pending, the rotation is finalized
+	//     with SET.
+	//
+	//   - SET - the new AUTH token replaces the previous one immediately.
+	//
+	//   - DELETE - the AUTH token is removed once AuthToken is unset. This is
+	//     only allowed when transitioning to RBAC.
+	//
+	// For more information, see Authenticating Users with AUTH (http://docs.aws.amazon.com/AmazonElastiCache/latest/dg/auth.html)
+	AuthTokenUpdateStrategy *string `json:"authTokenUpdateStrategy,omitempty"`
 	// Specifies whether a read-only replica is automatically promoted to read/write
 	// primary if the existing primary fails.
 	//
@@ -141,6 +175,27 @@ type ReplicationGroupSpec struct {
 	// see Subnets and Subnet Groups (https://docs.aws.amazon.com/AmazonElastiCache/latest/dg/SubnetGroups.html).
 	CacheSubnetGroupName *string                                  `json:"cacheSubnetGroupName,omitempty"`
 	CacheSubnetGroupRef  *ackv1alpha1.AWSResourceReferenceWrapper `json:"cacheSubnetGroupRef,omitempty"`
+	// Enabled or Disabled. To modify cluster mode from Disabled to Enabled, you
+	// must first set the cluster mode to Compatible. Compatible mode allows your
+	// Valkey or Redis OSS clients to connect using both cluster mode enabled and
+	// cluster mode disabled. After you migrate all Valkey or Redis OSS clients
+	// to use cluster mode enabled, you can then complete cluster mode configuration
+	// and set the cluster mode to Enabled.
+	//
+	// The controller only sets the cluster mode to Enabled once the
+	// elasticache.services.k8s.aws/confirm-cluster-mode-enabled annotation is
+	// set to true, confirming that all clients are cluster-aware.
+	ClusterMode *string `json:"clusterMode,omitempty"`
+	// Publishes the endpoints of the replication group into a Secret. When
+	// cluster mode is enabled the host is the configuration endpoint; otherwise
+	// the host and reader host are the primary and reader endpoints of the
+	// node group.
+	ConnectionSecret *ConnectionSecret `json:"connectionSecret,omitempty"`
+	// When true, a final snapshot is taken when the replication group is deleted.
+	// If FinalSnapshotIdentifier is not set, the snapshot is named after the
+	// replication group and the time it was deleted, for example
+	// my-replication-group-final-20260102-150405.
+	CreateFinalSnapshot *bool `json:"createFinalSnapshot,omitempty"`
 	// Enables data tiering. Data tiering is only supported for replication groups
 	// using the r6gd node type. This parameter must be set to true when using r6gd
 	// nodes. For more information, see Data tiering (https://docs.aws.amazon.com/AmazonElastiCache/latest/dg/data-tiering.html).
@@ -168,6 +223,15 @@ type ReplicationGroupSpec struct {
 	// existing cluster or replication group and create it anew with the earlier
 	// engine version.
 	EngineVersion *string `json:"engineVersion,omitempty"`
+	// The name of a final node group (shard) snapshot. ElastiCache creates the
+	// snapshot from the primary node in the cluster, rather than one of the replicas;
+	// this is to ensure that it captures the freshest data. After the final snapshot
+	// is taken, the replication group is immediately deleted.
+	FinalSnapshotIdentifier *string `json:"finalSnapshotIdentifier,omitempty"`
+	// The name of the Global datastore
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
+	GlobalReplicationGroupID  *string                                  `json:"globalReplicationGroupID,omitempty"`
+	GlobalReplicationGroupRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"globalReplicationGroupRef,omitempty"`
 	// The network type you choose when creating a replication group, either ipv4
 	// | ipv6. IPv6 is supported for workloads using Valkey 7.2 and above, Redis
 	// OSS engine version 6.2 to 7.1 or Memcached engine version 1.6.6 and above
@@ -274,12 +338,29 @@ type ReplicationGroupSpec struct {
 	//
 	// +kubebuilder:validation:Required
 	ReplicationGroupID *string `json:"replicationGroupID"`
+	// If set to true, all of the read replicas are deleted, but the primary node
+	// is retained.
+	RetainPrimaryCluster *bool `json:"retainPrimaryCluster,omitempty"`
 	// One or more Amazon VPC security groups associated with this replication group.
 	//
 	// Use this parameter only when you are creating a replication group in an Amazon
 	// Virtual Private Cloud (Amazon VPC).
 	SecurityGroupIDs  []*string                                  `json:"securityGroupIDs,omitempty"`
 	SecurityGroupRefs []*ackv1alpha1.AWSResourceReferenceWrapper `json:"securityGroupRefs,omitempty"`
+	// The name of the snapshot used to create a replication group. Available for
+	// Valkey, Redis OSS only.
+	ServerlessCacheSnapshotName *string                                  `json:"serverlessCacheSnapshotName,omitempty"`
+	ServerlessCacheSnapshotRef  *ackv1alpha1.AWSResourceReferenceWrapper `json:"serverlessCacheSnapshotRef,omitempty"`
+	// The name of a self-service update to apply to this replication group. The update
+	// is applied once it shows up in Status.PendingUpdateActions, during
+	// ServiceUpdateWindow when one is set.
+	ServiceUpdateName *string `json:"serviceUpdateName,omitempty"`
+	// The weekly time range (in UTC) during which ServiceUpdateName may be applied,
+	// in the format ddd:hh24:mi-ddd:hh24:mi. An update that is still running when
+	// the window closes is stopped and resumed during the next window.
+	//
+	// Example: sun:23:00-mon:01:30
+	ServiceUpdateWindow *string `json:"serviceUpdateWindow,omitempty"`
 	// A list of Amazon Resource Names (ARN) that uniquely identify the Valkey or
 	// Redis OSS RDB snapshot files stored in Amazon S3. The snapshot files are
 	// used to populate the new replication group. The Amazon S3 object name in
@@ -331,6 +412,21 @@ type ReplicationGroupSpec struct {
 	// For HIPAA compliance, you must specify TransitEncryptionEnabled as true,
 	// an AuthToken, and a CacheSubnetGroup.
 	TransitEncryptionEnabled *bool `json:"transitEncryptionEnabled,omitempty"`
+	// A setting that allows you to migrate your clients to use in-transit encryption,
+	// with no downtime.
+	//
+	// When setting TransitEncryptionEnabled to true, you can set your TransitEncryptionMode
+	// to preferred in the same request, to allow both encrypted and unencrypted
+	// connections at the same time. Once you migrate all your Valkey or Redis OSS
+	// clients to use encrypted connections you can set the value to required to
+	// allow encrypted connections only.
+	//
+	// Setting TransitEncryptionMode to required is a two-step process that requires
+	// you to first set the TransitEncryptionMode to preferred, after that you can
+	// set TransitEncryptionMode to required.
+	//
+	// This process will not trigger the replacement of the replication group.
+	TransitEncryptionMode *string `json:"transitEncryptionMode,omitempty"`
 	// The user group to associate with the replication group.
 	UserGroupIDs  []*string                                  `json:"userGroupIDs,omitempty"`
 	UserGroupRefs []*ackv1alpha1.AWSResourceReferenceWrapper `json:"userGroupRefs,omitempty"`
@@ -434,6 +530,10 @@ type ReplicationGroupStatus struct {
 	// or during the next maintenance window.
 	// +kubebuilder:validation:Optional
 	PendingModifiedValues *ReplicationGroupPendingModifiedValues `json:"pendingModifiedValues,omitempty"`
+	// The self-service updates that are available for this replication group and have
+	// not been applied yet.
+	// +kubebuilder:validation:Optional
+	PendingUpdateActions []*UpdateAction `json:"pendingUpdateActions,omitempty"`
 	// The date and time when the cluster was created.
 	// +kubebuilder:validation:Optional
 	ReplicationGroupCreateTime *metav1.Time `json:"replicationGroupCreateTime,omitempty"`
@@ -451,6 +551,11 @@ type ReplicationGroupStatus struct {
 	// KMS key is used.
 	// +kubebuilder:validation:Optional
 	StorageEncryptionType *string `json:"storageEncryptionType,omitempty"`
+	// The step of an in-transit encryption migration currently being applied to
+	// the replication group - enabling-preferred, enforcing-required, relaxing-to-preferred
+	// or disabling. It is unset when no migration is in progress.
+	// +kubebuilder:validation:Optional
+	TransitEncryptionMigrationPhase *string `json:"transitEncryptionMigrationPhase,omitempty"`
 }
 
 // ReplicationGroup is the Schema for the ReplicationGroups API
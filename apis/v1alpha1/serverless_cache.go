@@ -28,6 +28,9 @@ type ServerlessCacheSpec struct {
 	// Sets the cache usage limits for storage and ElastiCache Processing Units
 	// for the cache.
 	CacheUsageLimits *CacheUsageLimits `json:"cacheUsageLimits,omitempty"`
+	// Publishes the endpoint and reader endpoint of the serverless cache into a
+	// Secret. Serverless caches always use in-transit encryption.
+	ConnectionSecret *ConnectionSecret `json:"connectionSecret,omitempty"`
 	// The daily time that snapshots will be created from the new serverless cache.
 	// By default this number is populated with 0, i.e. no snapshots will be created
 	// on an automatic daily basis. Available for Valkey, Redis OSS and Serverless
@@ -48,6 +51,11 @@ type ServerlessCacheSpec struct {
 	// The version of the cache engine that will be used to create the serverless
 	// cache.
 	MajorEngineVersion *string `json:"majorEngineVersion,omitempty"`
+	// The ARN(s) of the node-based snapshots that the new serverless cache will
+	// be created from, in addition to SnapshotARNsToRestore. Use NodeSnapshotARNsToRestoreRefs
+	// to reference Snapshot resources.
+	NodeSnapshotARNsToRestore     []*string                                  `json:"nodeSnapshotARNsToRestore,omitempty"`
+	NodeSnapshotARNsToRestoreRefs []*ackv1alpha1.AWSResourceReferenceWrapper `json:"nodeSnapshotARNsToRestoreRefs,omitempty"`
 	// A list of the one or more VPC security groups to be associated with the serverless
 	// cache. The security group will authorize traffic access for the VPC end-point
 	// (private-link). If no other information is given this will be the VPC’s
@@ -60,7 +68,8 @@ type ServerlessCacheSpec struct {
 	ServerlessCacheName *string `json:"serverlessCacheName"`
 	// The ARN(s) of the snapshot that the new serverless cache will be created
 	// from. Available for Valkey, Redis OSS and Serverless Memcached only.
-	SnapshotARNsToRestore []*string `json:"snapshotARNsToRestore,omitempty"`
+	SnapshotARNsToRestore     []*string                                  `json:"snapshotARNsToRestore,omitempty"`
+	SnapshotARNsToRestoreRefs []*ackv1alpha1.AWSResourceReferenceWrapper `json:"snapshotARNsToRestoreRefs,omitempty"`
 	// The number of days for which ElastiCache retains automatic snapshots before
 	// deleting them. Available for Valkey, Redis OSS and Serverless Memcached only.
 	// The maximum value allowed is 35 days.
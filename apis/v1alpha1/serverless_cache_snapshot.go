@@ -31,6 +31,12 @@ type ServerlessCacheSnapshotSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	KMSKeyID  *string                                  `json:"kmsKeyID,omitempty"`
 	KMSKeyRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"kmsKeyRef,omitempty"`
+	// Name of the Amazon S3 bucket to export the snapshot to. The Amazon S3 bucket
+	// must also be in same region as the snapshot. Available for Valkey and Redis OSS
+	// only.
+	S3BucketName *string `json:"s3BucketName,omitempty"`
+	// Reference to an ACK S3 Bucket whose name is used as S3BucketName.
+	S3BucketRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"s3BucketRef,omitempty"`
 	// The name of an existing serverless cache. The snapshot is created from this
 	// cache. Available for Valkey, Redis OSS and Serverless Memcached only.
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
@@ -42,6 +48,11 @@ type ServerlessCacheSnapshotSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	// +kubebuilder:validation:Required
 	ServerlessCacheSnapshotName *string `json:"serverlessCacheSnapshotName"`
+	// The identifier of the existing serverless cache’s snapshot to be copied.
+	// Available for Valkey, Redis OSS and Serverless Memcached only.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
+	SourceSnapshotName *string                                  `json:"sourceSnapshotName,omitempty"`
+	SourceSnapshotRef  *ackv1alpha1.AWSResourceReferenceWrapper `json:"sourceSnapshotRef,omitempty"`
 	// A list of tags to be added to the snapshot resource. A tag is a key-value
 	// pair. Available for Valkey, Redis OSS and Serverless Memcached only.
 	Tags []*Tag `json:"tags,omitempty"`
@@ -69,6 +80,16 @@ type ServerlessCacheSnapshotStatus struct {
 	// Memcached only.
 	// +kubebuilder:validation:Optional
 	CreateTime *metav1.Time `json:"createTime,omitempty"`
+	// The name of the Amazon S3 bucket the snapshot was most recently exported
+	// to.
+	// +kubebuilder:validation:Optional
+	ExportBucket *string `json:"exportBucket,omitempty"`
+	// The progress of the most recent export of the snapshot to ExportBucket.
+	// One of "exporting" or "finished". ElastiCache does not report whether the
+	// export succeeded: "finished" means the snapshot is available again, and
+	// the exported files should be checked in the bucket.
+	// +kubebuilder:validation:Optional
+	ExportStatus *string `json:"exportStatus,omitempty"`
 	// The time that the serverless cache snapshot will expire. Available for Valkey,
 	// Redis OSS and Serverless Memcached only.
 	// +kubebuilder:validation:Optional
@@ -48,6 +48,19 @@ type SnapshotSpec struct {
 	// A list of tags to be added to this resource. A tag is a key-value pair. A
 	// tag key must be accompanied by a tag value, although null is accepted.
 	Tags []*Tag `json:"tags,omitempty"`
+	// The Amazon S3 bucket to which the snapshot is exported. This parameter is used
+	// only when exporting a snapshot for external access.
+	//
+	// When using this parameter to export a snapshot, be sure Amazon ElastiCache has
+	// the needed permissions to this S3 bucket. For more information, see Step
+	// 2: Grant ElastiCache Access to Your Amazon S3 Bucket (https://docs.aws.amazon.com/AmazonElastiCache/latest/dg/backups-exporting.html#backups-exporting-grant-access)
+	// in the Amazon ElastiCache User Guide.
+	//
+	// For more information, see Exporting a Snapshot (https://docs.aws.amazon.com/AmazonElastiCache/latest/dg/backups-exporting.html)
+	// in the Amazon ElastiCache User Guide.
+	TargetBucket *string `json:"targetBucket,omitempty"`
+	// Reference to an ACK S3 Bucket whose name is used as TargetBucket.
+	TargetBucketRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"targetBucketRef,omitempty"`
 }
 
 // SnapshotStatus defines the observed state of Snapshot
@@ -146,6 +159,16 @@ type SnapshotStatus struct {
 	// nodes. For more information, see Data tiering (https://docs.aws.amazon.com/AmazonElastiCache/latest/dg/data-tiering.html).
 	// +kubebuilder:validation:Optional
 	DataTiering *string `json:"dataTiering,omitempty"`
+	// The name of the Amazon S3 bucket the snapshot was most recently exported
+	// to.
+	// +kubebuilder:validation:Optional
+	ExportBucket *string `json:"exportBucket,omitempty"`
+	// The progress of the most recent export of the snapshot to ExportBucket.
+	// One of "exporting" or "finished". ElastiCache does not report whether the
+	// export succeeded: "finished" means the snapshot is available again, and
+	// the exported files should be checked in the bucket.
+	// +kubebuilder:validation:Optional
+	ExportStatus *string `json:"exportStatus,omitempty"`
 	// The name of the cache engine (memcached or redis) used by the source cluster.
 	// +kubebuilder:validation:Optional
 	Engine *string `json:"engine,omitempty"`
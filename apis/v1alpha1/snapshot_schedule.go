@@ -0,0 +1,95 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import (
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SnapshotScheduleSpec defines the desired state of SnapshotSchedule.
+//
+// A SnapshotSchedule creates a Snapshot of a ReplicationGroup or CacheCluster,
+// or a ServerlessCacheSnapshot of a ServerlessCache, each time its schedule
+// fires, and deletes the ones it created once they exceed the retention
+// limits. Unlike the daily automatic backups configured by SnapshotWindow and
+// SnapshotRetentionLimit, these snapshots are ordinary resources that can be
+// taken as often as hourly and kept for as long as needed.
+//
+// +kubebuilder:validation:XValidation:rule="[has(self.cacheClusterRef), has(self.replicationGroupRef), has(self.serverlessCacheRef)].filter(x, x).size() == 1",message="exactly one of cacheClusterRef, replicationGroupRef or serverlessCacheRef must be set"
+type SnapshotScheduleSpec struct {
+
+	// The cache cluster to snapshot.
+	CacheClusterRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"cacheClusterRef,omitempty"`
+	// The replication group to snapshot.
+	ReplicationGroupRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"replicationGroupRef,omitempty"`
+	// The number of snapshots created by the schedule to keep. When a new
+	// snapshot is created, the oldest ones beyond this count are deleted.
+	// +kubebuilder:validation:Minimum=1
+	RetentionCount *int64 `json:"retentionCount,omitempty"`
+	// How long to keep the snapshots created by the schedule, for example
+	// "168h". Older snapshots are deleted.
+	RetentionPeriod *metav1.Duration `json:"retentionPeriod,omitempty"`
+	// A standard five field cron expression, evaluated in UTC, that determines
+	// when snapshots are taken. The @hourly, @daily, @weekly, @monthly and
+	// @yearly shorthands are also accepted.
+	// +kubebuilder:validation:Required
+	Schedule *string `json:"schedule"`
+	// The serverless cache to snapshot.
+	ServerlessCacheRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"serverlessCacheRef,omitempty"`
+}
+
+// SnapshotScheduleStatus defines the observed state of SnapshotSchedule
+type SnapshotScheduleStatus struct {
+	// All CRs managed by ACK have a common `Status.Conditions` member that
+	// contains a collection of `ackv1alpha1.Condition` objects that describe
+	// the various terminal states of the CR and its backend AWS service API
+	// resource
+	// +kubebuilder:validation:Optional
+	Conditions []*ackv1alpha1.Condition `json:"conditions"`
+	// The time the schedule last created a snapshot.
+	// +kubebuilder:validation:Optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// The name of the snapshot resource the schedule created last.
+	// +kubebuilder:validation:Optional
+	LastSnapshotName *string `json:"lastSnapshotName,omitempty"`
+	// The time the schedule will next create a snapshot.
+	// +kubebuilder:validation:Optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+}
+
+// SnapshotSchedule is the Schema for the SnapshotSchedules API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="SCHEDULE",type=string,priority=0,JSONPath=`.spec.schedule`
+// +kubebuilder:printcolumn:name="LAST SCHEDULE",type=date,priority=0,JSONPath=`.status.lastScheduleTime`
+// +kubebuilder:printcolumn:name="Age",type="date",priority=0,JSONPath=".metadata.creationTimestamp"
+type SnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              SnapshotScheduleSpec   `json:"spec,omitempty"`
+	Status            SnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// SnapshotScheduleList contains a list of SnapshotSchedule
+// +kubebuilder:object:root=true
+type SnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SnapshotSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SnapshotSchedule{}, &SnapshotScheduleList{})
+}
@@ -351,18 +351,20 @@ type GlobalNodeGroup struct {
 //
 //   - The GlobalReplicationGroupIdSuffix represents the name of the Global
 //     datastore, which is what you use to associate a secondary cluster.
-type GlobalReplicationGroup struct {
-	ARN                               *string `json:"arn,omitempty"`
-	AtRestEncryptionEnabled           *bool   `json:"atRestEncryptionEnabled,omitempty"`
-	AuthTokenEnabled                  *bool   `json:"authTokenEnabled,omitempty"`
-	CacheNodeType                     *string `json:"cacheNodeType,omitempty"`
-	ClusterEnabled                    *bool   `json:"clusterEnabled,omitempty"`
-	Engine                            *string `json:"engine,omitempty"`
-	EngineVersion                     *string `json:"engineVersion,omitempty"`
-	GlobalReplicationGroupDescription *string `json:"globalReplicationGroupDescription,omitempty"`
-	GlobalReplicationGroupID          *string `json:"globalReplicationGroupID,omitempty"`
-	Status                            *string `json:"status,omitempty"`
-	TransitEncryptionEnabled          *bool   `json:"transitEncryptionEnabled,omitempty"`
+type GlobalReplicationGroup_SDK struct {
+	ARN                               *string                         `json:"arn,omitempty"`
+	AtRestEncryptionEnabled           *bool                           `json:"atRestEncryptionEnabled,omitempty"`
+	AuthTokenEnabled                  *bool                           `json:"authTokenEnabled,omitempty"`
+	CacheNodeType                     *string                         `json:"cacheNodeType,omitempty"`
+	ClusterEnabled                    *bool                           `json:"clusterEnabled,omitempty"`
+	Engine                            *string                         `json:"engine,omitempty"`
+	EngineVersion                     *string                         `json:"engineVersion,omitempty"`
+	GlobalNodeGroups                  []*GlobalNodeGroup              `json:"globalNodeGroups,omitempty"`
+	GlobalReplicationGroupDescription *string                         `json:"globalReplicationGroupDescription,omitempty"`
+	GlobalReplicationGroupID          *string                         `json:"globalReplicationGroupID,omitempty"`
+	Members                           []*GlobalReplicationGroupMember `json:"members,omitempty"`
+	Status                            *string                         `json:"status,omitempty"`
+	TransitEncryptionEnabled          *bool                           `json:"transitEncryptionEnabled,omitempty"`
 }
 
 // The name of the Global datastore and role of this replication group in the
@@ -609,19 +611,20 @@ type ReplicationGroup_SDK struct {
 }
 
 // Represents the output of a PurchaseReservedCacheNodesOffering operation.
-type ReservedCacheNode struct {
-	CacheNodeCount               *int64       `json:"cacheNodeCount,omitempty"`
-	CacheNodeType                *string      `json:"cacheNodeType,omitempty"`
-	Duration                     *int64       `json:"duration,omitempty"`
-	FixedPrice                   *float64     `json:"fixedPrice,omitempty"`
-	OfferingType                 *string      `json:"offeringType,omitempty"`
-	ProductDescription           *string      `json:"productDescription,omitempty"`
-	ReservationARN               *string      `json:"reservationARN,omitempty"`
-	ReservedCacheNodeID          *string      `json:"reservedCacheNodeID,omitempty"`
-	ReservedCacheNodesOfferingID *string      `json:"reservedCacheNodesOfferingID,omitempty"`
-	StartTime                    *metav1.Time `json:"startTime,omitempty"`
-	State                        *string      `json:"state,omitempty"`
-	UsagePrice                   *float64     `json:"usagePrice,omitempty"`
+type ReservedCacheNode_SDK struct {
+	CacheNodeCount               *int64             `json:"cacheNodeCount,omitempty"`
+	CacheNodeType                *string            `json:"cacheNodeType,omitempty"`
+	Duration                     *int64             `json:"duration,omitempty"`
+	FixedPrice                   *float64           `json:"fixedPrice,omitempty"`
+	OfferingType                 *string            `json:"offeringType,omitempty"`
+	ProductDescription           *string            `json:"productDescription,omitempty"`
+	RecurringCharges             []*RecurringCharge `json:"recurringCharges,omitempty"`
+	ReservationARN               *string            `json:"reservationARN,omitempty"`
+	ReservedCacheNodeID          *string            `json:"reservedCacheNodeID,omitempty"`
+	ReservedCacheNodesOfferingID *string            `json:"reservedCacheNodesOfferingID,omitempty"`
+	StartTime                    *metav1.Time       `json:"startTime,omitempty"`
+	State                        *string            `json:"state,omitempty"`
+	UsagePrice                   *float64           `json:"usagePrice,omitempty"`
 }
 
 // Describes all of the attributes of a reserved cache node offering.
@@ -815,7 +818,12 @@ type UpdateAction struct {
 	ServiceUpdateName                   *string      `json:"serviceUpdateName,omitempty"`
 	ServiceUpdateRecommendedApplyByDate *metav1.Time `json:"serviceUpdateRecommendedApplyByDate,omitempty"`
 	ServiceUpdateReleaseDate            *metav1.Time `json:"serviceUpdateReleaseDate,omitempty"`
+	ServiceUpdateSeverity               *string      `json:"serviceUpdateSeverity,omitempty"`
+	ServiceUpdateStatus                 *string      `json:"serviceUpdateStatus,omitempty"`
+	ServiceUpdateType                   *string      `json:"serviceUpdateType,omitempty"`
+	SlaMet                              *string      `json:"slaMet,omitempty"`
 	UpdateActionAvailableDate           *metav1.Time `json:"updateActionAvailableDate,omitempty"`
+	UpdateActionStatus                  *string      `json:"updateActionStatus,omitempty"`
 	UpdateActionStatusModifiedDate      *metav1.Time `json:"updateActionStatusModifiedDate,omitempty"`
 }
 
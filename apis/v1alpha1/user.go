@@ -41,6 +41,11 @@ type UserSpec struct {
 	NoPasswordRequired *bool `json:"noPasswordRequired,omitempty"`
 	// Passwords used for this user. You can create up to two passwords for each
 	// user.
+	//
+	// To rotate a password without downtime, reference a new secret or key. The
+	// controller first adds the new password next to the old one, then removes
+	// the old one once the user is active again. A password updated in place in
+	// its secret replaces the old one right away.
 	Passwords []*ackv1alpha1.SecretKeyReference `json:"passwords,omitempty"`
 	// A list of tags to be added to this resource. A tag is a key-value pair. A
 	// tag key must be accompanied by a tag value, although null is accepted.
@@ -21,6 +21,7 @@ package v1alpha1
 
 import (
 	corev1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -167,6 +168,11 @@ func (in *CacheClusterSpec) DeepCopyInto(out *CacheClusterSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ApplyImmediately != nil {
+		in, out := &in.ApplyImmediately, &out.ApplyImmediately
+		*out = new(bool)
+		**out = **in
+	}
 	if in.AuthToken != nil {
 		in, out := &in.AuthToken, &out.AuthToken
 		*out = new(corev1alpha1.SecretKeyReference)
@@ -218,6 +224,16 @@ func (in *CacheClusterSpec) DeepCopyInto(out *CacheClusterSpec) {
 		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ConnectionSecret != nil {
+		in, out := &in.ConnectionSecret, &out.ConnectionSecret
+		*out = new(ConnectionSecret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreateFinalSnapshot != nil {
+		in, out := &in.CreateFinalSnapshot, &out.CreateFinalSnapshot
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Engine != nil {
 		in, out := &in.Engine, &out.Engine
 		*out = new(string)
@@ -228,6 +244,11 @@ func (in *CacheClusterSpec) DeepCopyInto(out *CacheClusterSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FinalSnapshotIdentifier != nil {
+		in, out := &in.FinalSnapshotIdentifier, &out.FinalSnapshotIdentifier
+		*out = new(string)
+		**out = **in
+	}
 	if in.IPDiscovery != nil {
 		in, out := &in.IPDiscovery, &out.IPDiscovery
 		*out = new(string)
@@ -343,6 +364,16 @@ func (in *CacheClusterSpec) DeepCopyInto(out *CacheClusterSpec) {
 			}
 		}
 	}
+	if in.ServiceUpdateName != nil {
+		in, out := &in.ServiceUpdateName, &out.ServiceUpdateName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceUpdateWindow != nil {
+		in, out := &in.ServiceUpdateWindow, &out.ServiceUpdateWindow
+		*out = new(string)
+		**out = **in
+	}
 	if in.SnapshotARNs != nil {
 		in, out := &in.SnapshotARNs, &out.SnapshotARNs
 		*out = make([]*string, len(*in))
@@ -491,6 +522,17 @@ func (in *CacheClusterStatus) DeepCopyInto(out *CacheClusterStatus) {
 		*out = new(PendingModifiedValues)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PendingUpdateActions != nil {
+		in, out := &in.PendingUpdateActions, &out.PendingUpdateActions
+		*out = make([]*UpdateAction, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(UpdateAction)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.ReplicationGroupLogDeliveryEnabled != nil {
 		in, out := &in.ReplicationGroupLogDeliveryEnabled, &out.ReplicationGroupLogDeliveryEnabled
 		*out = new(bool)
@@ -1547,6 +1589,26 @@ func (in *ConfigureShard) DeepCopy() *ConfigureShard {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionSecret) DeepCopyInto(out *ConnectionSecret) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionSecret.
+func (in *ConnectionSecret) DeepCopy() *ConnectionSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomerNodeEndpoint) DeepCopyInto(out *CustomerNodeEndpoint) {
 	*out = *in
@@ -1836,61 +1898,10 @@ func (in *GlobalNodeGroup) DeepCopy() *GlobalNodeGroup {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalReplicationGroup) DeepCopyInto(out *GlobalReplicationGroup) {
 	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.AtRestEncryptionEnabled != nil {
-		in, out := &in.AtRestEncryptionEnabled, &out.AtRestEncryptionEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.AuthTokenEnabled != nil {
-		in, out := &in.AuthTokenEnabled, &out.AuthTokenEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.CacheNodeType != nil {
-		in, out := &in.CacheNodeType, &out.CacheNodeType
-		*out = new(string)
-		**out = **in
-	}
-	if in.ClusterEnabled != nil {
-		in, out := &in.ClusterEnabled, &out.ClusterEnabled
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Engine != nil {
-		in, out := &in.Engine, &out.Engine
-		*out = new(string)
-		**out = **in
-	}
-	if in.EngineVersion != nil {
-		in, out := &in.EngineVersion, &out.EngineVersion
-		*out = new(string)
-		**out = **in
-	}
-	if in.GlobalReplicationGroupDescription != nil {
-		in, out := &in.GlobalReplicationGroupDescription, &out.GlobalReplicationGroupDescription
-		*out = new(string)
-		**out = **in
-	}
-	if in.GlobalReplicationGroupID != nil {
-		in, out := &in.GlobalReplicationGroupID, &out.GlobalReplicationGroupID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Status != nil {
-		in, out := &in.Status, &out.Status
-		*out = new(string)
-		**out = **in
-	}
-	if in.TransitEncryptionEnabled != nil {
-		in, out := &in.TransitEncryptionEnabled, &out.TransitEncryptionEnabled
-		*out = new(bool)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroup.
@@ -1903,6 +1914,14 @@ func (in *GlobalReplicationGroup) DeepCopy() *GlobalReplicationGroup {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalReplicationGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalReplicationGroupInfo) DeepCopyInto(out *GlobalReplicationGroupInfo) {
 	*out = *in
@@ -1928,6 +1947,38 @@ func (in *GlobalReplicationGroupInfo) DeepCopy() *GlobalReplicationGroupInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalReplicationGroupList) DeepCopyInto(out *GlobalReplicationGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GlobalReplicationGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupList.
+func (in *GlobalReplicationGroupList) DeepCopy() *GlobalReplicationGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GlobalReplicationGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalReplicationGroupMember) DeepCopyInto(out *GlobalReplicationGroupMember) {
 	*out = *in
@@ -1969,94 +2020,340 @@ func (in *GlobalReplicationGroupMember) DeepCopy() *GlobalReplicationGroupMember
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KinesisFirehoseDestinationDetails) DeepCopyInto(out *KinesisFirehoseDestinationDetails) {
+func (in *GlobalReplicationGroupSpec) DeepCopyInto(out *GlobalReplicationGroupSpec) {
 	*out = *in
-	if in.DeliveryStream != nil {
-		in, out := &in.DeliveryStream, &out.DeliveryStream
+	if in.GlobalReplicationGroupDescription != nil {
+		in, out := &in.GlobalReplicationGroupDescription, &out.GlobalReplicationGroupDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalReplicationGroupIDSuffix != nil {
+		in, out := &in.GlobalReplicationGroupIDSuffix, &out.GlobalReplicationGroupIDSuffix
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrimaryRegion != nil {
+		in, out := &in.PrimaryRegion, &out.PrimaryRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrimaryReplicationGroupID != nil {
+		in, out := &in.PrimaryReplicationGroupID, &out.PrimaryReplicationGroupID
 		*out = new(string)
 		**out = **in
 	}
+	if in.PrimaryReplicationGroupRef != nil {
+		in, out := &in.PrimaryReplicationGroupRef, &out.PrimaryReplicationGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecondaryReplicationGroups != nil {
+		in, out := &in.SecondaryReplicationGroups, &out.SecondaryReplicationGroups
+		*out = make([]*GlobalReplicationGroupMember, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(GlobalReplicationGroupMember)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KinesisFirehoseDestinationDetails.
-func (in *KinesisFirehoseDestinationDetails) DeepCopy() *KinesisFirehoseDestinationDetails {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupSpec.
+func (in *GlobalReplicationGroupSpec) DeepCopy() *GlobalReplicationGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KinesisFirehoseDestinationDetails)
+	out := new(GlobalReplicationGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogDeliveryConfiguration) DeepCopyInto(out *LogDeliveryConfiguration) {
+func (in *GlobalReplicationGroupStatus) DeepCopyInto(out *GlobalReplicationGroupStatus) {
 	*out = *in
-	if in.DestinationDetails != nil {
-		in, out := &in.DestinationDetails, &out.DestinationDetails
-		*out = new(DestinationDetails)
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.DestinationType != nil {
-		in, out := &in.DestinationType, &out.DestinationType
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AtRestEncryptionEnabled != nil {
+		in, out := &in.AtRestEncryptionEnabled, &out.AtRestEncryptionEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AuthTokenEnabled != nil {
+		in, out := &in.AuthTokenEnabled, &out.AuthTokenEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CacheNodeType != nil {
+		in, out := &in.CacheNodeType, &out.CacheNodeType
 		*out = new(string)
 		**out = **in
 	}
-	if in.LogFormat != nil {
-		in, out := &in.LogFormat, &out.LogFormat
+	if in.ClusterEnabled != nil {
+		in, out := &in.ClusterEnabled, &out.ClusterEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CurrentPrimaryReplicationGroupID != nil {
+		in, out := &in.CurrentPrimaryReplicationGroupID, &out.CurrentPrimaryReplicationGroupID
 		*out = new(string)
 		**out = **in
 	}
-	if in.LogType != nil {
-		in, out := &in.LogType, &out.LogType
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
 		*out = new(string)
 		**out = **in
 	}
-	if in.Message != nil {
-		in, out := &in.Message, &out.Message
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
 		*out = new(string)
 		**out = **in
 	}
+	if in.GlobalNodeGroups != nil {
+		in, out := &in.GlobalNodeGroups, &out.GlobalNodeGroups
+		*out = make([]*GlobalNodeGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(GlobalNodeGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.GlobalReplicationGroupID != nil {
+		in, out := &in.GlobalReplicationGroupID, &out.GlobalReplicationGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]*GlobalReplicationGroupMember, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(GlobalReplicationGroupMember)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
+	if in.TransitEncryptionEnabled != nil {
+		in, out := &in.TransitEncryptionEnabled, &out.TransitEncryptionEnabled
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogDeliveryConfiguration.
-func (in *LogDeliveryConfiguration) DeepCopy() *LogDeliveryConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroupStatus.
+func (in *GlobalReplicationGroupStatus) DeepCopy() *GlobalReplicationGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LogDeliveryConfiguration)
+	out := new(GlobalReplicationGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogDeliveryConfigurationRequest) DeepCopyInto(out *LogDeliveryConfigurationRequest) {
+func (in *GlobalReplicationGroup_SDK) DeepCopyInto(out *GlobalReplicationGroup_SDK) {
 	*out = *in
-	if in.DestinationDetails != nil {
-		in, out := &in.DestinationDetails, &out.DestinationDetails
-		*out = new(DestinationDetails)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DestinationType != nil {
-		in, out := &in.DestinationType, &out.DestinationType
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
+	if in.AtRestEncryptionEnabled != nil {
+		in, out := &in.AtRestEncryptionEnabled, &out.AtRestEncryptionEnabled
 		*out = new(bool)
 		**out = **in
 	}
-	if in.LogFormat != nil {
-		in, out := &in.LogFormat, &out.LogFormat
-		*out = new(string)
+	if in.AuthTokenEnabled != nil {
+		in, out := &in.AuthTokenEnabled, &out.AuthTokenEnabled
+		*out = new(bool)
 		**out = **in
 	}
-	if in.LogType != nil {
+	if in.CacheNodeType != nil {
+		in, out := &in.CacheNodeType, &out.CacheNodeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClusterEnabled != nil {
+		in, out := &in.ClusterEnabled, &out.ClusterEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineVersion != nil {
+		in, out := &in.EngineVersion, &out.EngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalNodeGroups != nil {
+		in, out := &in.GlobalNodeGroups, &out.GlobalNodeGroups
+		*out = make([]*GlobalNodeGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(GlobalNodeGroup)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.GlobalReplicationGroupDescription != nil {
+		in, out := &in.GlobalReplicationGroupDescription, &out.GlobalReplicationGroupDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalReplicationGroupID != nil {
+		in, out := &in.GlobalReplicationGroupID, &out.GlobalReplicationGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]*GlobalReplicationGroupMember, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(GlobalReplicationGroupMember)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitEncryptionEnabled != nil {
+		in, out := &in.TransitEncryptionEnabled, &out.TransitEncryptionEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalReplicationGroup_SDK.
+func (in *GlobalReplicationGroup_SDK) DeepCopy() *GlobalReplicationGroup_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalReplicationGroup_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KinesisFirehoseDestinationDetails) DeepCopyInto(out *KinesisFirehoseDestinationDetails) {
+	*out = *in
+	if in.DeliveryStream != nil {
+		in, out := &in.DeliveryStream, &out.DeliveryStream
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KinesisFirehoseDestinationDetails.
+func (in *KinesisFirehoseDestinationDetails) DeepCopy() *KinesisFirehoseDestinationDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(KinesisFirehoseDestinationDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogDeliveryConfiguration) DeepCopyInto(out *LogDeliveryConfiguration) {
+	*out = *in
+	if in.DestinationDetails != nil {
+		in, out := &in.DestinationDetails, &out.DestinationDetails
+		*out = new(DestinationDetails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationType != nil {
+		in, out := &in.DestinationType, &out.DestinationType
+		*out = new(string)
+		**out = **in
+	}
+	if in.LogFormat != nil {
+		in, out := &in.LogFormat, &out.LogFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.LogType != nil {
+		in, out := &in.LogType, &out.LogType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogDeliveryConfiguration.
+func (in *LogDeliveryConfiguration) DeepCopy() *LogDeliveryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LogDeliveryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogDeliveryConfigurationRequest) DeepCopyInto(out *LogDeliveryConfigurationRequest) {
+	*out = *in
+	if in.DestinationDetails != nil {
+		in, out := &in.DestinationDetails, &out.DestinationDetails
+		*out = new(DestinationDetails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationType != nil {
+		in, out := &in.DestinationType, &out.DestinationType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LogFormat != nil {
+		in, out := &in.LogFormat, &out.LogFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.LogType != nil {
 		in, out := &in.LogType, &out.LogType
 		*out = new(string)
 		**out = **in
@@ -2738,6 +3035,16 @@ func (in *ReplicationGroupPendingModifiedValues) DeepCopy() *ReplicationGroupPen
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicationGroupSpec) DeepCopyInto(out *ReplicationGroupSpec) {
 	*out = *in
+	if in.AdoptFinalSnapshot != nil {
+		in, out := &in.AdoptFinalSnapshot, &out.AdoptFinalSnapshot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ApplyImmediately != nil {
+		in, out := &in.ApplyImmediately, &out.ApplyImmediately
+		*out = new(bool)
+		**out = **in
+	}
 	if in.AtRestEncryptionEnabled != nil {
 		in, out := &in.AtRestEncryptionEnabled, &out.AtRestEncryptionEnabled
 		*out = new(bool)
@@ -2748,6 +3055,11 @@ func (in *ReplicationGroupSpec) DeepCopyInto(out *ReplicationGroupSpec) {
 		*out = new(corev1alpha1.SecretKeyReference)
 		**out = **in
 	}
+	if in.AuthTokenUpdateStrategy != nil {
+		in, out := &in.AuthTokenUpdateStrategy, &out.AuthTokenUpdateStrategy
+		*out = new(string)
+		**out = **in
+	}
 	if in.AutomaticFailoverEnabled != nil {
 		in, out := &in.AutomaticFailoverEnabled, &out.AutomaticFailoverEnabled
 		*out = new(bool)
@@ -2789,6 +3101,21 @@ func (in *ReplicationGroupSpec) DeepCopyInto(out *ReplicationGroupSpec) {
 		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClusterMode != nil {
+		in, out := &in.ClusterMode, &out.ClusterMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectionSecret != nil {
+		in, out := &in.ConnectionSecret, &out.ConnectionSecret
+		*out = new(ConnectionSecret)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreateFinalSnapshot != nil {
+		in, out := &in.CreateFinalSnapshot, &out.CreateFinalSnapshot
+		*out = new(bool)
+		**out = **in
+	}
 	if in.DataTieringEnabled != nil {
 		in, out := &in.DataTieringEnabled, &out.DataTieringEnabled
 		*out = new(bool)
@@ -2814,6 +3141,21 @@ func (in *ReplicationGroupSpec) DeepCopyInto(out *ReplicationGroupSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FinalSnapshotIdentifier != nil {
+		in, out := &in.FinalSnapshotIdentifier, &out.FinalSnapshotIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalReplicationGroupID != nil {
+		in, out := &in.GlobalReplicationGroupID, &out.GlobalReplicationGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalReplicationGroupRef != nil {
+		in, out := &in.GlobalReplicationGroupRef, &out.GlobalReplicationGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IPDiscovery != nil {
 		in, out := &in.IPDiscovery, &out.IPDiscovery
 		*out = new(string)
@@ -2912,6 +3254,11 @@ func (in *ReplicationGroupSpec) DeepCopyInto(out *ReplicationGroupSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RetainPrimaryCluster != nil {
+		in, out := &in.RetainPrimaryCluster, &out.RetainPrimaryCluster
+		*out = new(bool)
+		**out = **in
+	}
 	if in.SecurityGroupIDs != nil {
 		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
 		*out = make([]*string, len(*in))
@@ -2934,6 +3281,26 @@ func (in *ReplicationGroupSpec) DeepCopyInto(out *ReplicationGroupSpec) {
 			}
 		}
 	}
+	if in.ServerlessCacheSnapshotName != nil {
+		in, out := &in.ServerlessCacheSnapshotName, &out.ServerlessCacheSnapshotName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServerlessCacheSnapshotRef != nil {
+		in, out := &in.ServerlessCacheSnapshotRef, &out.ServerlessCacheSnapshotRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceUpdateName != nil {
+		in, out := &in.ServiceUpdateName, &out.ServiceUpdateName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceUpdateWindow != nil {
+		in, out := &in.ServiceUpdateWindow, &out.ServiceUpdateWindow
+		*out = new(string)
+		**out = **in
+	}
 	if in.SnapshotARNs != nil {
 		in, out := &in.SnapshotARNs, &out.SnapshotARNs
 		*out = make([]*string, len(*in))
@@ -2981,6 +3348,11 @@ func (in *ReplicationGroupSpec) DeepCopyInto(out *ReplicationGroupSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.TransitEncryptionMode != nil {
+		in, out := &in.TransitEncryptionMode, &out.TransitEncryptionMode
+		*out = new(string)
+		**out = **in
+	}
 	if in.UserGroupIDs != nil {
 		in, out := &in.UserGroupIDs, &out.UserGroupIDs
 		*out = make([]*string, len(*in))
@@ -3165,6 +3537,17 @@ func (in *ReplicationGroupStatus) DeepCopyInto(out *ReplicationGroupStatus) {
 		*out = new(ReplicationGroupPendingModifiedValues)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PendingUpdateActions != nil {
+		in, out := &in.PendingUpdateActions, &out.PendingUpdateActions
+		*out = make([]*UpdateAction, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(UpdateAction)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.ReplicationGroupCreateTime != nil {
 		in, out := &in.ReplicationGroupCreateTime, &out.ReplicationGroupCreateTime
 		*out = (*in).DeepCopy()
@@ -3184,6 +3567,11 @@ func (in *ReplicationGroupStatus) DeepCopyInto(out *ReplicationGroupStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.TransitEncryptionMigrationPhase != nil {
+		in, out := &in.TransitEncryptionMigrationPhase, &out.TransitEncryptionMigrationPhase
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationGroupStatus.
@@ -3371,41 +3759,222 @@ func (in *ReplicationGroup_SDK) DeepCopyInto(out *ReplicationGroup_SDK) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.StorageEncryptionType != nil {
-		in, out := &in.StorageEncryptionType, &out.StorageEncryptionType
-		*out = new(string)
-		**out = **in
+	if in.StorageEncryptionType != nil {
+		in, out := &in.StorageEncryptionType, &out.StorageEncryptionType
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitEncryptionEnabled != nil {
+		in, out := &in.TransitEncryptionEnabled, &out.TransitEncryptionEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UserGroupIDs != nil {
+		in, out := &in.UserGroupIDs, &out.UserGroupIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationGroup_SDK.
+func (in *ReplicationGroup_SDK) DeepCopy() *ReplicationGroup_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationGroup_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedCacheNode) DeepCopyInto(out *ReservedCacheNode) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedCacheNode.
+func (in *ReservedCacheNode) DeepCopy() *ReservedCacheNode {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedCacheNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReservedCacheNode) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedCacheNodeList) DeepCopyInto(out *ReservedCacheNodeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReservedCacheNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedCacheNodeList.
+func (in *ReservedCacheNodeList) DeepCopy() *ReservedCacheNodeList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedCacheNodeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReservedCacheNodeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedCacheNodeSpec) DeepCopyInto(out *ReservedCacheNodeSpec) {
+	*out = *in
+	if in.CacheNodeCount != nil {
+		in, out := &in.CacheNodeCount, &out.CacheNodeCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CacheNodeType != nil {
+		in, out := &in.CacheNodeType, &out.CacheNodeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+	if in.OfferingType != nil {
+		in, out := &in.OfferingType, &out.OfferingType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProductDescription != nil {
+		in, out := &in.ProductDescription, &out.ProductDescription
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReservedCacheNodeID != nil {
+		in, out := &in.ReservedCacheNodeID, &out.ReservedCacheNodeID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReservedCacheNodesOfferingID != nil {
+		in, out := &in.ReservedCacheNodesOfferingID, &out.ReservedCacheNodesOfferingID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedCacheNodeSpec.
+func (in *ReservedCacheNodeSpec) DeepCopy() *ReservedCacheNodeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedCacheNodeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedCacheNodeStatus) DeepCopyInto(out *ReservedCacheNodeStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.TransitEncryptionEnabled != nil {
-		in, out := &in.TransitEncryptionEnabled, &out.TransitEncryptionEnabled
-		*out = new(bool)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.FixedPrice != nil {
+		in, out := &in.FixedPrice, &out.FixedPrice
+		*out = new(float64)
 		**out = **in
 	}
-	if in.UserGroupIDs != nil {
-		in, out := &in.UserGroupIDs, &out.UserGroupIDs
-		*out = make([]*string, len(*in))
+	if in.RecurringCharges != nil {
+		in, out := &in.RecurringCharges, &out.RecurringCharges
+		*out = make([]*RecurringCharge, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(string)
-				**out = **in
+				*out = new(RecurringCharge)
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.UsagePrice != nil {
+		in, out := &in.UsagePrice, &out.UsagePrice
+		*out = new(float64)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationGroup_SDK.
-func (in *ReplicationGroup_SDK) DeepCopy() *ReplicationGroup_SDK {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedCacheNodeStatus.
+func (in *ReservedCacheNodeStatus) DeepCopy() *ReservedCacheNodeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ReplicationGroup_SDK)
+	out := new(ReservedCacheNodeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReservedCacheNode) DeepCopyInto(out *ReservedCacheNode) {
+func (in *ReservedCacheNode_SDK) DeepCopyInto(out *ReservedCacheNode_SDK) {
 	*out = *in
 	if in.CacheNodeCount != nil {
 		in, out := &in.CacheNodeCount, &out.CacheNodeCount
@@ -3437,6 +4006,17 @@ func (in *ReservedCacheNode) DeepCopyInto(out *ReservedCacheNode) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RecurringCharges != nil {
+		in, out := &in.RecurringCharges, &out.RecurringCharges
+		*out = make([]*RecurringCharge, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RecurringCharge)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.ReservationARN != nil {
 		in, out := &in.ReservationARN, &out.ReservationARN
 		*out = new(string)
@@ -3468,12 +4048,12 @@ func (in *ReservedCacheNode) DeepCopyInto(out *ReservedCacheNode) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedCacheNode.
-func (in *ReservedCacheNode) DeepCopy() *ReservedCacheNode {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedCacheNode_SDK.
+func (in *ReservedCacheNode_SDK) DeepCopy() *ReservedCacheNode_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(ReservedCacheNode)
+	out := new(ReservedCacheNode_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3790,6 +4370,16 @@ func (in *ServerlessCacheSnapshotSpec) DeepCopyInto(out *ServerlessCacheSnapshot
 		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.S3BucketName != nil {
+		in, out := &in.S3BucketName, &out.S3BucketName
+		*out = new(string)
+		**out = **in
+	}
+	if in.S3BucketRef != nil {
+		in, out := &in.S3BucketRef, &out.S3BucketRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ServerlessCacheName != nil {
 		in, out := &in.ServerlessCacheName, &out.ServerlessCacheName
 		*out = new(string)
@@ -3805,6 +4395,16 @@ func (in *ServerlessCacheSnapshotSpec) DeepCopyInto(out *ServerlessCacheSnapshot
 		*out = new(string)
 		**out = **in
 	}
+	if in.SourceSnapshotName != nil {
+		in, out := &in.SourceSnapshotName, &out.SourceSnapshotName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceSnapshotRef != nil {
+		in, out := &in.SourceSnapshotRef, &out.SourceSnapshotRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]*Tag, len(*in))
@@ -3856,6 +4456,16 @@ func (in *ServerlessCacheSnapshotStatus) DeepCopyInto(out *ServerlessCacheSnapsh
 		in, out := &in.CreateTime, &out.CreateTime
 		*out = (*in).DeepCopy()
 	}
+	if in.ExportBucket != nil {
+		in, out := &in.ExportBucket, &out.ExportBucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExportStatus != nil {
+		in, out := &in.ExportStatus, &out.ExportStatus
+		*out = new(string)
+		**out = **in
+	}
 	if in.ExpiryTime != nil {
 		in, out := &in.ExpiryTime, &out.ExpiryTime
 		*out = (*in).DeepCopy()
@@ -3953,6 +4563,11 @@ func (in *ServerlessCacheSpec) DeepCopyInto(out *ServerlessCacheSpec) {
 		*out = new(CacheUsageLimits)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ConnectionSecret != nil {
+		in, out := &in.ConnectionSecret, &out.ConnectionSecret
+		*out = new(ConnectionSecret)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DailySnapshotTime != nil {
 		in, out := &in.DailySnapshotTime, &out.DailySnapshotTime
 		*out = new(string)
@@ -3983,6 +4598,28 @@ func (in *ServerlessCacheSpec) DeepCopyInto(out *ServerlessCacheSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.NodeSnapshotARNsToRestore != nil {
+		in, out := &in.NodeSnapshotARNsToRestore, &out.NodeSnapshotARNsToRestore
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.NodeSnapshotARNsToRestoreRefs != nil {
+		in, out := &in.NodeSnapshotARNsToRestoreRefs, &out.NodeSnapshotARNsToRestoreRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.SecurityGroupIDs != nil {
 		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
 		*out = make([]*string, len(*in))
@@ -4021,6 +4658,17 @@ func (in *ServerlessCacheSpec) DeepCopyInto(out *ServerlessCacheSpec) {
 			}
 		}
 	}
+	if in.SnapshotARNsToRestoreRefs != nil {
+		in, out := &in.SnapshotARNsToRestoreRefs, &out.SnapshotARNsToRestoreRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.SnapshotRetentionLimit != nil {
 		in, out := &in.SnapshotRetentionLimit, &out.SnapshotRetentionLimit
 		*out = new(int64)
@@ -4393,6 +5041,149 @@ func (in *SnapshotList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotSchedule) DeepCopyInto(out *SnapshotSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotSchedule.
+func (in *SnapshotSchedule) DeepCopy() *SnapshotSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SnapshotSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotScheduleList) DeepCopyInto(out *SnapshotScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SnapshotSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotScheduleList.
+func (in *SnapshotScheduleList) DeepCopy() *SnapshotScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SnapshotScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotScheduleSpec) DeepCopyInto(out *SnapshotScheduleSpec) {
+	*out = *in
+	if in.CacheClusterRef != nil {
+		in, out := &in.CacheClusterRef, &out.CacheClusterRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicationGroupRef != nil {
+		in, out := &in.ReplicationGroupRef, &out.ReplicationGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetentionCount != nil {
+		in, out := &in.RetentionCount, &out.RetentionCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RetentionPeriod != nil {
+		in, out := &in.RetentionPeriod, &out.RetentionPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServerlessCacheRef != nil {
+		in, out := &in.ServerlessCacheRef, &out.ServerlessCacheRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotScheduleSpec.
+func (in *SnapshotScheduleSpec) DeepCopy() *SnapshotScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotScheduleStatus) DeepCopyInto(out *SnapshotScheduleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSnapshotName != nil {
+		in, out := &in.LastSnapshotName, &out.LastSnapshotName
+		*out = new(string)
+		**out = **in
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotScheduleStatus.
+func (in *SnapshotScheduleStatus) DeepCopy() *SnapshotScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SnapshotSpec) DeepCopyInto(out *SnapshotSpec) {
 	*out = *in
@@ -4452,6 +5243,16 @@ func (in *SnapshotSpec) DeepCopyInto(out *SnapshotSpec) {
 			}
 		}
 	}
+	if in.TargetBucket != nil {
+		in, out := &in.TargetBucket, &out.TargetBucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetBucketRef != nil {
+		in, out := &in.TargetBucketRef, &out.TargetBucketRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotSpec.
@@ -4517,6 +5318,16 @@ func (in *SnapshotStatus) DeepCopyInto(out *SnapshotStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ExportBucket != nil {
+		in, out := &in.ExportBucket, &out.ExportBucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.ExportStatus != nil {
+		in, out := &in.ExportStatus, &out.ExportStatus
+		*out = new(string)
+		**out = **in
+	}
 	if in.Engine != nil {
 		in, out := &in.Engine, &out.Engine
 		*out = new(string)
@@ -4954,10 +5765,35 @@ func (in *UpdateAction) DeepCopyInto(out *UpdateAction) {
 		in, out := &in.ServiceUpdateReleaseDate, &out.ServiceUpdateReleaseDate
 		*out = (*in).DeepCopy()
 	}
+	if in.ServiceUpdateSeverity != nil {
+		in, out := &in.ServiceUpdateSeverity, &out.ServiceUpdateSeverity
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceUpdateStatus != nil {
+		in, out := &in.ServiceUpdateStatus, &out.ServiceUpdateStatus
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceUpdateType != nil {
+		in, out := &in.ServiceUpdateType, &out.ServiceUpdateType
+		*out = new(string)
+		**out = **in
+	}
+	if in.SlaMet != nil {
+		in, out := &in.SlaMet, &out.SlaMet
+		*out = new(string)
+		**out = **in
+	}
 	if in.UpdateActionAvailableDate != nil {
 		in, out := &in.UpdateActionAvailableDate, &out.UpdateActionAvailableDate
 		*out = (*in).DeepCopy()
 	}
+	if in.UpdateActionStatus != nil {
+		in, out := &in.UpdateActionStatus, &out.UpdateActionStatus
+		*out = new(string)
+		**out = **in
+	}
 	if in.UpdateActionStatusModifiedDate != nil {
 		in, out := &in.UpdateActionStatusModifiedDate, &out.UpdateActionStatusModifiedDate
 		*out = (*in).DeepCopy()
@@ -20,6 +20,7 @@ import (
 	"os"
 	goruntime "runtime"
 	"runtime/debug"
+	"slices"
 
 	ec2apitypes "github.com/aws-controllers-k8s/ec2-controller/apis/v1alpha1"
 	kmsapitypes "github.com/aws-controllers-k8s/kms-controller/apis/v1alpha1"
@@ -47,13 +48,17 @@ import (
 	_ "github.com/aws-controllers-k8s/elasticache-controller/pkg/resource/cache_cluster"
 	_ "github.com/aws-controllers-k8s/elasticache-controller/pkg/resource/cache_parameter_group"
 	_ "github.com/aws-controllers-k8s/elasticache-controller/pkg/resource/cache_subnet_group"
+	_ "github.com/aws-controllers-k8s/elasticache-controller/pkg/resource/global_replication_group"
 	_ "github.com/aws-controllers-k8s/elasticache-controller/pkg/resource/replication_group"
+	_ "github.com/aws-controllers-k8s/elasticache-controller/pkg/resource/reserved_cache_node"
 	_ "github.com/aws-controllers-k8s/elasticache-controller/pkg/resource/serverless_cache"
 	_ "github.com/aws-controllers-k8s/elasticache-controller/pkg/resource/serverless_cache_snapshot"
 	_ "github.com/aws-controllers-k8s/elasticache-controller/pkg/resource/snapshot"
 	_ "github.com/aws-controllers-k8s/elasticache-controller/pkg/resource/user"
 	_ "github.com/aws-controllers-k8s/elasticache-controller/pkg/resource/user_group"
 
+	"github.com/aws-controllers-k8s/elasticache-controller/pkg/schedule"
+	svcutil "github.com/aws-controllers-k8s/elasticache-controller/pkg/util"
 	"github.com/aws-controllers-k8s/elasticache-controller/pkg/version"
 )
 
@@ -92,6 +97,12 @@ func init() {
 func main() {
 	var ackCfg ackcfg.Config
 	ackCfg.BindFlags()
+	var eventsCfg svcutil.EventsConfig
+	eventsCfg.BindFlags()
+	var describeCacheCfg svcutil.DescribeCacheConfig
+	describeCacheCfg.BindFlags()
+	var authTokenCfg svcutil.AuthTokenConfig
+	authTokenCfg.BindFlags()
 	flag.Parse()
 	ackCfg.SetupLogger()
 
@@ -100,6 +111,9 @@ func main() {
 	for _, mf := range managerFactories {
 		resourceGVKs = append(resourceGVKs, mf.ResourceDescriptor().GroupVersionKind())
 	}
+	// SnapshotSchedule is not reconciled by the ACK runtime, but can be
+	// selected in the reconcile resources like the other resource kinds.
+	resourceGVKs = append(resourceGVKs, svctypes.GroupVersion.WithKind("SnapshotSchedule"))
 
 	ctx := context.Background()
 	if err := ackCfg.Validate(ctx, ackcfg.WithGVKs(resourceGVKs)); err != nil {
@@ -110,6 +124,31 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := eventsCfg.Validate(); err != nil {
+		setupLog.Error(
+			err, "invalid events configuration",
+			"aws.service", awsServiceAlias,
+		)
+		os.Exit(1)
+	}
+	svcutil.SetEventsConfig(eventsCfg)
+	if err := describeCacheCfg.Validate(); err != nil {
+		setupLog.Error(
+			err, "invalid describe cache configuration",
+			"aws.service", awsServiceAlias,
+		)
+		os.Exit(1)
+	}
+	svcutil.SetDescribeCacheConfig(describeCacheCfg)
+	if err := authTokenCfg.Validate(); err != nil {
+		setupLog.Error(
+			err, "invalid AUTH token configuration",
+			"aws.service", awsServiceAlias,
+		)
+		os.Exit(1)
+	}
+	svcutil.SetAuthTokenConfig(authTokenCfg)
+
 	host, port, err := ackrtutil.GetHostPort(ackCfg.WebhookServerAddr)
 	if err != nil {
 		setupLog.Error(
@@ -168,6 +207,9 @@ func main() {
 		)
 		os.Exit(1)
 	}
+	svcutil.SetKubeClient(mgr.GetClient())
+	svcutil.SetAPIReader(mgr.GetAPIReader())
+	svcutil.SetEventRecorder(mgr.GetEventRecorder("ack-" + awsServiceAlias + "-controller"))
 
 	stopChan := ctrlrt.SetupSignalHandler()
 
@@ -196,7 +238,7 @@ func main() {
 	).WithLogger(
 		ctrlrt.Log,
 	).WithResourceManagerFactories(
-		svcresource.GetManagerFactories(),
+		svcutil.WithSDKMiddleware(svcresource.GetManagerFactories()),
 	).WithPrometheusRegistry(
 		ctrlrtmetrics.Registry,
 	)
@@ -213,7 +255,8 @@ func main() {
 		}
 	}
 
-	if err = sc.BindControllerManager(mgr, ackCfg); err != nil {
+	ctrlRecorder := svcutil.NewControllerRecorder(mgr)
+	if err = sc.BindControllerManager(ctrlRecorder, ackCfg); err != nil {
 		setupLog.Error(
 			err, "unable bind to controller manager to service controller",
 			"aws.service", awsServiceAlias,
@@ -221,6 +264,32 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = svcutil.WatchSecrets(context.Background(), ctrlRecorder, sc.GetReconcilers()); err != nil {
+		setupLog.Error(
+			err, "unable to watch secrets referenced by resources",
+			"aws.service", awsServiceAlias,
+		)
+		os.Exit(1)
+	}
+
+	reconcileResources, err := ackCfg.GetReconcileResources()
+	if err != nil {
+		setupLog.Error(
+			err, "unable to parse reconcile resources",
+			"aws.service", awsServiceAlias,
+		)
+		os.Exit(1)
+	}
+	if len(reconcileResources) == 0 || slices.Contains(reconcileResources, "SnapshotSchedule") {
+		if err = schedule.SetupSnapshotScheduleController(mgr); err != nil {
+			setupLog.Error(
+				err, "unable to set up SnapshotSchedule controller",
+				"aws.service", awsServiceAlias,
+			)
+			os.Exit(1)
+		}
+	}
+
 	if err = mgr.AddHealthzCheck("health", ctrlrthealthz.Ping); err != nil {
 		setupLog.Error(
 			err, "unable to set up health check",
@@ -55,7 +55,6 @@ rules:
   - ""
   resources:
   - configmaps
-  - secrets
   verbs:
   - get
   - list
@@ -69,6 +68,15 @@ rules:
   - get
   - list
   - watch
+- apiGroups:
+  - ""
+  resources:
+  - secrets
+  verbs:
+  - get
+  - list
+  - patch
+  - watch
 - apiGroups:
   - ec2.services.k8s.aws
   resources:
@@ -85,10 +93,13 @@ rules:
   - cacheclusters
   - cacheparametergroups
   - cachesubnetgroups
+  - globalreplicationgroups
   - replicationgroups
+  - reservedcachenodes
   - serverlesscaches
   - serverlesscachesnapshots
   - snapshots
+  - snapshotschedules
   - usergroups
   - users
   verbs:
@@ -105,16 +116,26 @@ rules:
   - cacheclusters/status
   - cacheparametergroups/status
   - cachesubnetgroups/status
+  - globalreplicationgroups/status
   - replicationgroups/status
+  - reservedcachenodes/status
   - serverlesscaches/status
   - serverlesscachesnapshots/status
   - snapshots/status
+  - snapshotschedules/status
   - usergroups/status
   - users/status
   verbs:
   - get
   - patch
   - update
+- apiGroups:
+  - events.k8s.io
+  resources:
+  - events
+  verbs:
+  - create
+  - patch
 - apiGroups:
   - kms.services.k8s.aws
   resources:
@@ -123,6 +144,14 @@ rules:
   verbs:
   - get
   - list
+- apiGroups:
+  - s3.services.k8s.aws
+  resources:
+  - buckets
+  - buckets/status
+  verbs:
+  - get
+  - list
 - apiGroups:
   - services.k8s.aws
   resources:
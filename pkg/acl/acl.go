@@ -0,0 +1,245 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package acl parses the Valkey and Redis OSS ACL rules that make up the
+// access string of an ElastiCache user, so that access strings can be
+// validated before they are sent to ElastiCache and compared semantically
+// with the expanded form ElastiCache reports back.
+package acl
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	permissionRead      = "R"
+	permissionWrite     = "W"
+	permissionReadWrite = "RW"
+)
+
+var (
+	commandRegexp  = regexp.MustCompile(`^[a-z0-9_.-]+(\|[a-z0-9_.-]+)?$`)
+	categoryRegexp = regexp.MustCompile(`^[a-z0-9_-]+$`)
+)
+
+// Rules is the parsed form of an access string.
+type Rules struct {
+	// Enabled is true if the user is switched on. Users are off unless an
+	// "on" rule says otherwise.
+	Enabled bool
+	// Keys maps the key patterns the user can access to the permission
+	// granted on them, one of "R", "W" or "RW".
+	Keys map[string]string
+	// Channels are the Pub/Sub channel patterns the user can access.
+	Channels map[string]bool
+	// Commands are the command rules, such as "+@read" or "-flushall", in
+	// the order they are applied. Rules that are overridden by a later
+	// "+@all" or "-@all" are dropped.
+	Commands []string
+	// hasChannelRules is true if the access string contains channel rules.
+	hasChannelRules bool
+}
+
+// Parse parses an access string, returning an error that names the first
+// malformed rule.
+func Parse(accessString string) (*Rules, error) {
+	rules := &Rules{
+		Keys:     map[string]string{},
+		Channels: map[string]bool{},
+	}
+	for _, rule := range strings.Fields(accessString) {
+		if err := rules.apply(rule); err != nil {
+			return nil, fmt.Errorf("invalid access string rule %q: %w", rule, err)
+		}
+	}
+	return rules, nil
+}
+
+func (r *Rules) apply(rule string) error {
+	lower := strings.ToLower(rule)
+	switch lower {
+	case "on":
+		r.Enabled = true
+		return nil
+	case "off":
+		r.Enabled = false
+		return nil
+	case "allkeys":
+		r.addKeys("*", permissionReadWrite)
+		return nil
+	case "resetkeys":
+		r.Keys = map[string]string{}
+		return nil
+	case "allchannels":
+		r.hasChannelRules = true
+		r.Channels["*"] = true
+		return nil
+	case "resetchannels":
+		r.hasChannelRules = true
+		r.Channels = map[string]bool{}
+		return nil
+	case "allcommands":
+		r.addCommand("+@all")
+		return nil
+	case "nocommands":
+		r.addCommand("-@all")
+		return nil
+	case "nopass", "resetpass":
+		return fmt.Errorf("passwords are not part of an access string")
+	case "reset":
+		return fmt.Errorf("reset is not supported")
+	}
+
+	switch {
+	case strings.HasPrefix(rule, "~"):
+		return r.addKeys(rule[1:], permissionReadWrite)
+	case strings.HasPrefix(rule, "%"):
+		permission, pattern, ok := strings.Cut(rule[1:], "~")
+		permission = strings.ToUpper(permission)
+		if !ok || (permission != permissionRead && permission != permissionWrite && permission != permissionReadWrite) {
+			return fmt.Errorf("key permissions must be one of %%R~, %%W~ or %%RW~")
+		}
+		return r.addKeys(pattern, permission)
+	case strings.HasPrefix(rule, "&"):
+		if rule == "&" {
+			return fmt.Errorf("channel pattern is empty")
+		}
+		r.hasChannelRules = true
+		r.Channels[rule[1:]] = true
+		return nil
+	case strings.HasPrefix(rule, "+@"), strings.HasPrefix(rule, "-@"):
+		if !categoryRegexp.MatchString(lower[2:]) {
+			return fmt.Errorf("invalid command category")
+		}
+		r.addCommand(lower)
+		return nil
+	case strings.HasPrefix(rule, "+"), strings.HasPrefix(rule, "-"):
+		if !commandRegexp.MatchString(lower[1:]) {
+			return fmt.Errorf("invalid command name")
+		}
+		r.addCommand(lower)
+		return nil
+	case strings.HasPrefix(rule, ">"), strings.HasPrefix(rule, "<"),
+		strings.HasPrefix(rule, "#"), strings.HasPrefix(rule, "!"):
+		return fmt.Errorf("passwords are not part of an access string")
+	case strings.HasPrefix(rule, "("):
+		return fmt.Errorf("selectors are not supported")
+	}
+	return fmt.Errorf("unknown rule")
+}
+
+// addKeys grants permission on the supplied key pattern, on top of the
+// permission already granted on it.
+func (r *Rules) addKeys(pattern string, permission string) error {
+	if pattern == "" {
+		return fmt.Errorf("key pattern is empty")
+	}
+	current := r.Keys[pattern]
+	if current != "" && current != permission {
+		permission = permissionReadWrite
+	}
+	r.Keys[pattern] = permission
+	return nil
+}
+
+// addCommand appends a command rule. "+@all" and "-@all" override every rule
+// before them, which are dropped.
+func (r *Rules) addCommand(rule string) {
+	if rule == "+@all" || rule == "-@all" {
+		r.Commands = nil
+	}
+	r.Commands = append(r.Commands, rule)
+}
+
+// Equivalent returns true if the supplied rules, typically the expanded
+// access string reported by ElastiCache, grant the same access as r. Channel
+// patterns are only compared if r contains channel rules, because ElastiCache
+// adds a default channel rule that depends on the engine version.
+func (r *Rules) Equivalent(other *Rules) bool {
+	if r.Enabled != other.Enabled {
+		return false
+	}
+	if len(r.Keys) != len(other.Keys) {
+		return false
+	}
+	for pattern, permission := range r.Keys {
+		if other.Keys[pattern] != permission {
+			return false
+		}
+	}
+	if r.hasChannelRules {
+		if len(r.Channels) != len(other.Channels) {
+			return false
+		}
+		for pattern := range r.Channels {
+			if !other.Channels[pattern] {
+				return false
+			}
+		}
+	}
+	return commandsEquivalent(r.Commands, other.Commands)
+}
+
+// commandsEquivalent returns true if both lists of command rules grant the
+// same commands. The leading "+@all" or "-@all" must match. The rules after it
+// are compared in order, because a category rule may cover a command rule
+// before it, except within runs of consecutive rules of the same sign, which
+// grant the same commands in any order.
+func commandsEquivalent(a []string, b []string) bool {
+	if len(a) > 0 && len(b) > 0 && isAllCommandsRule(a[0]) && isAllCommandsRule(b[0]) {
+		if a[0] != b[0] {
+			return false
+		}
+		a, b = a[1:], b[1:]
+	}
+	return slices.EqualFunc(commandRuns(a), commandRuns(b), slices.Equal[[]string])
+}
+
+// isAllCommandsRule returns true if rule is "+@all" or "-@all".
+func isAllCommandsRule(rule string) bool {
+	return rule == "+@all" || rule == "-@all"
+}
+
+// commandRuns splits the supplied rules into runs of consecutive rules of the
+// same sign, each sorted without duplicates.
+func commandRuns(rules []string) [][]string {
+	var runs [][]string
+	for i, rule := range rules {
+		if i == 0 || rules[i-1][0] != rule[0] {
+			runs = append(runs, nil)
+		}
+		runs[len(runs)-1] = append(runs[len(runs)-1], rule)
+	}
+	for i, run := range runs {
+		slices.Sort(run)
+		runs[i] = slices.Compact(run)
+	}
+	return runs
+}
+
+// Equivalent parses both access strings and returns true if they grant the
+// same access. See Rules.Equivalent.
+func Equivalent(requested string, expanded string) (bool, error) {
+	requestedRules, err := Parse(requested)
+	if err != nil {
+		return false, err
+	}
+	expandedRules, err := Parse(expanded)
+	if err != nil {
+		return false, err
+	}
+	return requestedRules.Equivalent(expandedRules), nil
+}
@@ -0,0 +1,193 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package acl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_Parse(t *testing.T) {
+	tests := []struct {
+		name         string
+		accessString string
+		want         *Rules
+		wantErr      bool
+	}{
+		{
+			name:         "Full Access",
+			accessString: "on ~* +@all",
+			want: &Rules{
+				Enabled:  true,
+				Keys:     map[string]string{"*": "RW"},
+				Channels: map[string]bool{},
+				Commands: []string{"+@all"},
+			},
+		},
+		{
+			name:         "Aliases",
+			accessString: "on allkeys allchannels allcommands -flushall",
+			want: &Rules{
+				Enabled:         true,
+				Keys:            map[string]string{"*": "RW"},
+				Channels:        map[string]bool{"*": true},
+				Commands:        []string{"+@all", "-flushall"},
+				hasChannelRules: true,
+			},
+		},
+		{
+			name:         "Key Permissions",
+			accessString: "off %R~app:* %W~app:* %R~cache:* &events:* -@all +get +client|setname",
+			want: &Rules{
+				Keys:            map[string]string{"app:*": "RW", "cache:*": "R"},
+				Channels:        map[string]bool{"events:*": true},
+				Commands:        []string{"-@all", "+get", "+client|setname"},
+				hasChannelRules: true,
+			},
+		},
+		{
+			name:         "Overridden Commands",
+			accessString: "on ~* +get -set +@all -@dangerous",
+			want: &Rules{
+				Enabled:  true,
+				Keys:     map[string]string{"*": "RW"},
+				Channels: map[string]bool{},
+				Commands: []string{"+@all", "-@dangerous"},
+			},
+		},
+		{
+			name:         "Password",
+			accessString: "on ~* +@all >secret",
+			wantErr:      true,
+		},
+		{
+			name:         "Empty Key Pattern",
+			accessString: "on ~ +@all",
+			wantErr:      true,
+		},
+		{
+			name:         "Invalid Key Permission",
+			accessString: "on %X~* +@all",
+			wantErr:      true,
+		},
+		{
+			name:         "Invalid Command",
+			accessString: "on ~* +get$",
+			wantErr:      true,
+		},
+		{
+			name:         "Unknown Rule",
+			accessString: "on ~* +@all everything",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.accessString)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) && !tt.wantErr {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Equivalent(t *testing.T) {
+	tests := []struct {
+		name      string
+		requested string
+		expanded  string
+		want      bool
+	}{
+		{
+			name:      "Default Channels Added",
+			requested: "on ~* +@all",
+			expanded:  "on ~* &* +@all",
+			want:      true,
+		},
+		{
+			name:      "Aliases And Order",
+			requested: "on allkeys allcommands",
+			expanded:  "on ~* +@all",
+			want:      true,
+		},
+		{
+			name:      "Case Of Commands",
+			requested: "on ~app:* -@ALL +GET",
+			expanded:  "on ~app:* -@all +get",
+			want:      true,
+		},
+		{
+			name:      "Switched Off",
+			requested: "on ~* +@all",
+			expanded:  "off ~* +@all",
+			want:      false,
+		},
+		{
+			name:      "Key Pattern Changed",
+			requested: "on ~app:* +@all",
+			expanded:  "on ~* +@all",
+			want:      false,
+		},
+		{
+			name:      "Channels Changed",
+			requested: "on ~* &events:* +@all",
+			expanded:  "on ~* &* +@all",
+			want:      false,
+		},
+		{
+			name:      "Command Added",
+			requested: "on ~* -@all +get",
+			expanded:  "on ~* -@all +get +set",
+			want:      false,
+		},
+		{
+			name:      "Order Of Commands",
+			requested: "on ~* -@all +set +get",
+			expanded:  "on ~* -@all +get +set",
+			want:      true,
+		},
+		{
+			name:      "Order Of Allowed And Denied Command",
+			requested: "on ~* +@all -flushall +flushall",
+			expanded:  "on ~* +@all +flushall -flushall",
+			want:      false,
+		},
+		{
+			name:      "Order Of Category And Command",
+			requested: "on ~* -@all +@read -get",
+			expanded:  "on ~* -@all -get +@read",
+			want:      false,
+		},
+		{
+			name:      "Order Of Denied Commands",
+			requested: "on ~* +@all -flushall -flushdb +get",
+			expanded:  "on ~* +@all -flushdb -flushall +get",
+			want:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Equivalent(tt.requested, tt.expanded)
+			if err != nil {
+				t.Fatalf("Equivalent() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Equivalent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
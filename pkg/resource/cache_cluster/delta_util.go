@@ -53,6 +53,15 @@ func modifyDelta(
 	}
 
 	updatePAZsDelta(desired, delta)
+
+	if util.ServiceUpdateActionRequired(desired.ko.Spec.ServiceUpdateName, desired.ko.Spec.ServiceUpdateWindow,
+		latest.ko.Status.PendingUpdateActions) {
+		delta.Add("Spec.ServiceUpdateName", desired.ko.Spec.ServiceUpdateName, nil)
+	}
+
+	if util.ConnectionSecretOutdated(latest.ko, desired.ko.Spec.ConnectionSecret, connectionDetails(desired, latest)) {
+		delta.Add("Spec.ConnectionSecret", desired.ko.Spec.ConnectionSecret, nil)
+	}
 }
 
 // updatePAZsDelta retrieves the last requested configurations saved in annotations and compares them
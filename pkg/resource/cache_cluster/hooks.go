@@ -19,11 +19,14 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
+	"time"
 
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
-	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 
 	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
 	"github.com/aws-controllers-k8s/elasticache-controller/pkg/util"
@@ -36,27 +39,39 @@ const (
 	statusDeleting  = "deleting"
 )
 
+const (
+	engineMemcached = "memcached"
+)
+
 const (
 	// AnnotationLastRequestedPAZs is an annotation whose value is a JSON representation of []*string,
 	// passed in as input to either the create or modify API called most recently.
 	AnnotationLastRequestedPAZs = svcapitypes.AnnotationPrefix + "last-requested-preferred-availability-zones"
+	// annotationObservedAuthToken is an annotation set on the latest observed resource only, whose value is the
+	// version of the secret currently referenced by the AUTH token
+	annotationObservedAuthToken = svcapitypes.AnnotationPrefix + "observed-auth-token-secret-version"
 )
 
 var (
 	condMsgCurrentlyDeleting      = "CacheCluster is currently being deleted"
 	condMsgNoDeleteWhileModifying = "Cannot delete CacheCluster while it is being modified"
+	condMsgNoMemcachedSnapshot    = "Memcached CacheClusters do not support snapshots, " +
+		"unset Spec.CreateFinalSnapshot and Spec.FinalSnapshotIdentifier"
 )
 
-var (
-	requeueWaitWhileDeleting = ackrequeue.NeededAfter(
+func (rm *resourceManager) requeueWaitWhileDeleting(r *resource) error {
+	return util.RequeueWaitWhile(
 		fmt.Errorf("CacheCluster is in %q state, it cannot be deleted", statusDeleting),
-		ackrequeue.DefaultRequeueAfterDuration,
+		"CacheCluster", r.ko, util.TransitionDeleting, rm.awsAccountID, rm.awsRegion,
 	)
-	requeueWaitWhileModifying = ackrequeue.NeededAfter(
+}
+
+func (rm *resourceManager) requeueWaitWhileModifying(r *resource) error {
+	return util.RequeueWaitWhile(
 		fmt.Errorf("CacheCluster is in %q state, it cannot be modified", statusModifying),
-		ackrequeue.DefaultRequeueAfterDuration,
+		"CacheCluster", r.ko, util.TransitionModifying, rm.awsAccountID, rm.awsRegion,
 	)
-)
+}
 
 func hasStatus(r *resource, status string) bool {
 	return r.ko.Status.CacheClusterStatus != nil && *r.ko.Status.CacheClusterStatus == status
@@ -70,6 +85,14 @@ func isAvailable(r *resource) bool {
 	return hasStatus(r, statusAvailable)
 }
 
+// endTransitionIfAvailable forgets the transition of the cache cluster once
+// it is available.
+func endTransitionIfAvailable(r *resource) {
+	if isAvailable(r) {
+		util.EndTransition("CacheCluster", r.ko)
+	}
+}
+
 func isDeleting(r *resource) bool {
 	return hasStatus(r, statusDeleting)
 }
@@ -78,6 +101,51 @@ func isModifying(r *resource) bool {
 	return hasStatus(r, statusModifying)
 }
 
+func isMemcached(r *resource) bool {
+	return r.ko.Spec.Engine != nil && strings.EqualFold(*r.ko.Spec.Engine, engineMemcached)
+}
+
+// finalSnapshotRequested returns true if a final snapshot should be taken when
+// the cache cluster is deleted.
+func finalSnapshotRequested(r *resource) bool {
+	return r.ko.Spec.FinalSnapshotIdentifier != nil ||
+		(r.ko.Spec.CreateFinalSnapshot != nil && *r.ko.Spec.CreateFinalSnapshot)
+}
+
+// validateFinalSnapshot returns a terminal error if a final snapshot is
+// requested for a Memcached cache cluster, which cannot be snapshotted. Such a
+// cache cluster is not deleted until the final snapshot is no longer
+// requested.
+func validateFinalSnapshot(r *resource) error {
+	if finalSnapshotRequested(r) && isMemcached(r) {
+		return ackerr.NewTerminalError(errors.New(condMsgNoMemcachedSnapshot))
+	}
+	return nil
+}
+
+// finalSnapshotIdentifier returns the name of the snapshot to take when the
+// cache cluster is deleted, or nil if no final snapshot was requested. A
+// generated name is derived from the deletion timestamp of the resource so
+// that it does not change between reconciliations.
+func finalSnapshotIdentifier(r *resource) *string {
+	if !finalSnapshotRequested(r) {
+		return nil
+	}
+	if r.ko.Spec.FinalSnapshotIdentifier != nil {
+		return r.ko.Spec.FinalSnapshotIdentifier
+	}
+	deletedAt := time.Now()
+	if r.ko.DeletionTimestamp != nil {
+		deletedAt = r.ko.DeletionTimestamp.Time
+	}
+	name := fmt.Sprintf(
+		"%s-final-%s",
+		*r.ko.Spec.CacheClusterID,
+		deletedAt.UTC().Format("20060102-150405"),
+	)
+	return &name
+}
+
 // getTags retrieves the resource's associated tags.
 func (rm *resourceManager) getTags(
 	ctx context.Context,
@@ -95,7 +163,69 @@ func (rm *resourceManager) syncTags(
 	return util.SyncTags(ctx, desired.ko.Spec.Tags, latest.ko.Spec.Tags, latest.ko.Status.ACKResourceMetadata, convertToOrderedACKTags, rm.sdkapi, rm.metrics)
 }
 
+// connectionDetails returns the connection details of the cache cluster, with
+// the endpoint observed in latest. Memcached clusters are reached through
+// their configuration endpoint, others through their cache node.
+func connectionDetails(desired *resource, latest *resource) util.ConnectionDetails {
+	details := util.ConnectionDetails{
+		TLS:              desired.ko.Spec.TransitEncryptionEnabled != nil && *desired.ko.Spec.TransitEncryptionEnabled,
+		AuthToken:        desired.ko.Spec.AuthToken,
+		AuthTokenVersion: latest.ko.GetAnnotations()[annotationObservedAuthToken],
+	}
+	if latest.ko.Status.ConfigurationEndpoint != nil {
+		details.Endpoint = latest.ko.Status.ConfigurationEndpoint
+	} else if len(latest.ko.Status.CacheNodes) > 0 && latest.ko.Status.CacheNodes[0] != nil {
+		details.Endpoint = latest.ko.Status.CacheNodes[0].Endpoint
+	}
+	return details
+}
+
+// setPendingUpdateActions sets the service updates that have not been applied
+// to the cache cluster yet, so that pending security and engine updates are
+// reported whether or not Spec.ServiceUpdateName names one to apply.
+func (rm *resourceManager) setPendingUpdateActions(
+	ctx context.Context,
+	ko *svcapitypes.CacheCluster,
+) error {
+	updateActions, err := util.GetPendingUpdateActions(ctx, rm.sdkapi, rm.metrics, nil, ko.Spec.CacheClusterID)
+	if err != nil {
+		return err
+	}
+	ko.Status.PendingUpdateActions = updateActions
+	return nil
+}
+
+// syncServiceUpdate applies or stops the service update requested in
+// Spec.ServiceUpdateName.
+func (rm *resourceManager) syncServiceUpdate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) error {
+	return util.SyncServiceUpdate(
+		ctx,
+		rm.sdkapi,
+		rm.metrics,
+		desired.ko.Spec.ServiceUpdateName,
+		desired.ko.Spec.ServiceUpdateWindow,
+		latest.ko.Status.PendingUpdateActions,
+		nil,
+		latest.ko.Spec.CacheClusterID,
+	)
+}
+
+// applyImmediately returns whether modifications are applied as soon as
+// possible rather than during the next maintenance window, which is the
+// default.
+func applyImmediately(r *resource) *bool {
+	if r.ko.Spec.ApplyImmediately == nil {
+		return aws.Bool(true)
+	}
+	return r.ko.Spec.ApplyImmediately
+}
+
 func (rm *resourceManager) updateCacheClusterPayload(input *svcsdk.ModifyCacheClusterInput, desired, latest *resource, delta *ackcompare.Delta) error {
+	input.ApplyImmediately = applyImmediately(desired)
 	desiredSpec := desired.ko.Spec
 	var nodesDelta int64
 	if delta.DifferentAt("Spec.NumCacheNodes") && desired.ko.Spec.NumCacheNodes != nil {
@@ -210,3 +340,80 @@ func Int64OrNil(i *int32) *int64 {
 	}
 	return aws.Int64(0)
 }
+
+// setObservedAuthToken records the version of the secret currently referenced
+// by the AUTH token on the latest observed resource, so that a rotated token
+// is published into the connection Secret. A secret that cannot be read
+// is logged and skipped, so that it never blocks reads of the cache cluster.
+func (rm *resourceManager) setObservedAuthToken(
+	ctx context.Context,
+	r *resource,
+	ko *svcapitypes.CacheCluster,
+) {
+	if r.ko.Spec.AuthToken == nil || r.ko.Spec.ConnectionSecret == nil {
+		return
+	}
+	version, err := util.SecretVersion(ctx, r.ko.Spec.AuthToken, r.ko.Namespace)
+	if err != nil {
+		rm.log.V(1).Info("unable to read AUTH token secret", "error", err)
+		return
+	}
+	annotations := getAnnotationsFields(r, ko)
+	annotations[annotationObservedAuthToken] = version
+}
+
+// recordEvents emits Kubernetes Events for the ElastiCache events of the
+// cache cluster that have not been emitted yet. Emitting events is best-effort, so
+// errors are logged rather than failing the read.
+func (rm *resourceManager) recordEvents(
+	ctx context.Context,
+	ko *svcapitypes.CacheCluster,
+) {
+	events, err := util.DescribeEvents(
+		ctx, rm.sdkapi, rm.metrics, rm.awsAccountID, rm.awsRegion,
+		svcsdktypes.SourceTypeCacheCluster, ko.Spec.CacheClusterID,
+	)
+	if err != nil {
+		rm.log.V(1).Info("Error during DescribeEvents-CacheCluster", "error", err)
+		return
+	}
+	util.RecordEvents(ko, events)
+}
+
+// describeFromCache returns a context in which the cache cluster is described from the
+// describe cache, if the cache cluster is available and the describe cache can serve it.
+// A cache cluster in any other state is described directly, so that its transitions are
+// observed without delay.
+func (rm *resourceManager) describeFromCache(
+	ctx context.Context,
+	r *resource,
+) context.Context {
+	if r.ko.Status.CacheClusterStatus == nil || *r.ko.Status.CacheClusterStatus != statusAvailable {
+		return ctx
+	}
+	cc, ok, err := util.CachedCacheCluster(
+		ctx, rm.sdkapi, rm.metrics, rm.awsAccountID, rm.awsRegion, r.ko.Spec.CacheClusterID,
+	)
+	if err != nil {
+		rm.log.V(1).Info("Error during DescribeCacheClusters for the describe cache", "error", err)
+		return ctx
+	}
+	if !ok || cc.CacheClusterStatus == nil || *cc.CacheClusterStatus != statusAvailable {
+		return ctx
+	}
+	return util.WithCachedOutput(ctx, "DescribeCacheClusters", &svcsdk.DescribeCacheClustersOutput{
+		CacheClusters: []svcsdktypes.CacheCluster{*cc},
+	})
+}
+
+// invalidateDescribeCache stops the cache cluster from being served from the
+// describe cache until it is listed again.
+func (rm *resourceManager) invalidateDescribeCache(r *resource) {
+	util.InvalidateDescribeCache(rm.awsAccountID, rm.awsRegion, r.ko.Spec.CacheClusterID)
+}
+
+// requeueWhileThrottled returns a requeue error while ElastiCache requests of
+// the account and region are throttled, and nil otherwise.
+func (rm *resourceManager) requeueWhileThrottled() error {
+	return util.RequeueWhileThrottled(rm.awsAccountID, rm.awsRegion)
+}
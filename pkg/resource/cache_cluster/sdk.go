@@ -37,6 +37,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/elasticache-controller/pkg/util"
 )
 
 // Hack to avoid import errors during build...
@@ -71,6 +72,11 @@ func (rm *resourceManager) sdkFind(
 		return nil, ackerr.NotFound
 	}
 
+	if err := rm.requeueWhileThrottled(); err != nil {
+		return nil, err
+	}
+	ctx = rm.describeFromCache(ctx, r)
+
 	input, err := rm.newListRequestPayload(r)
 	if err != nil {
 		return nil, err
@@ -380,6 +386,9 @@ func (rm *resourceManager) sdkFind(
 		if pendingModifications.CacheNodeType != nil {
 			ko.Spec.CacheNodeType = pendingModifications.CacheNodeType
 		}
+		if pendingModifications.EngineVersion != nil {
+			ko.Spec.EngineVersion = pendingModifications.EngineVersion
+		}
 		if pendingModifications.TransitEncryptionEnabled != nil {
 			ko.Spec.TransitEncryptionEnabled = pendingModifications.TransitEncryptionEnabled
 		}
@@ -396,6 +405,19 @@ func (rm *resourceManager) sdkFind(
 		ko.Spec.SecurityGroupIDs = nil
 	}
 
+	if err := rm.setPendingUpdateActions(ctx, ko); err != nil {
+		return nil, err
+	}
+
+	rm.setObservedAuthToken(ctx, r, ko)
+	rm.recordEvents(ctx, ko)
+	util.ForgetDeletedConnectionSecret(ctx, ko, ko.Spec.ConnectionSecret)
+	endTransitionIfAvailable(&resource{ko})
+
+	if err := validateFinalSnapshot(&resource{ko}); err != nil {
+		ackcondition.SetTerminal(&resource{ko}, corev1.ConditionTrue, &condMsgNoMemcachedSnapshot, nil)
+	}
+
 	if isAvailable(r) {
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionTrue, nil, nil)
 	} else {
@@ -452,6 +474,9 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	if err = validateFinalSnapshot(desired); err != nil {
+		return nil, err
+	}
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
@@ -933,6 +958,23 @@ func (rm *resourceManager) sdkUpdate(
 	defer func() {
 		exit(err)
 	}()
+	defer rm.invalidateDescribeCache(desired)
+
+	done, err := util.SyncConnectionSecret(
+		ctx, delta, desired.ko, desired.ko.Spec.ConnectionSecret,
+		func() util.ConnectionDetails { return connectionDetails(desired, latest) },
+		rm.rr.SecretValueFromReference,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if done {
+		// The connection Secret was the only difference.
+		return desired, nil
+	}
+	if err = validateFinalSnapshot(desired); err != nil {
+		return nil, err
+	}
 	if delta.DifferentAt("Spec.Tags") {
 		if err = rm.syncTags(ctx, desired, latest); err != nil {
 			return nil, err
@@ -942,6 +984,13 @@ func (rm *resourceManager) sdkUpdate(
 		// Spec.Tags field, we can skip the ModifyCacheCluster call.
 		return desired, nil
 	}
+	if delta.DifferentAt("Spec.ServiceUpdateName") && !delta.DifferentExcept("Spec.Tags", "Spec.ServiceUpdateName") {
+		// Service updates are applied once every other difference is resolved.
+		if err = rm.syncServiceUpdate(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		return desired, nil
+	}
 
 	input, err := rm.newUpdateRequestPayload(ctx, desired, delta)
 	if err != nil {
@@ -1247,6 +1296,9 @@ func (rm *resourceManager) sdkUpdate(
 		if pendingModifications.CacheNodeType != nil {
 			ko.Spec.CacheNodeType = pendingModifications.CacheNodeType
 		}
+		if pendingModifications.EngineVersion != nil {
+			ko.Spec.EngineVersion = pendingModifications.EngineVersion
+		}
 		if pendingModifications.TransitEncryptionEnabled != nil {
 			ko.Spec.TransitEncryptionEnabled = pendingModifications.TransitEncryptionEnabled
 		}
@@ -1269,7 +1321,9 @@ func (rm *resourceManager) newUpdateRequestPayload(
 	if r.ko.Spec.AZMode != nil {
 		res.AZMode = svcsdktypes.AZMode(*r.ko.Spec.AZMode)
 	}
-	res.ApplyImmediately = aws.Bool(true)
+	if r.ko.Spec.ApplyImmediately != nil {
+		res.ApplyImmediately = r.ko.Spec.ApplyImmediately
+	}
 	if r.ko.Spec.AuthToken != nil {
 		tmpSecret, err := rm.rr.SecretValueFromReference(ctx, r.ko.Spec.AuthToken)
 		if err != nil {
@@ -1397,7 +1451,7 @@ func (rm *resourceManager) sdkDelete(
 		// - reconciler.HandleReconcileError() does not update status for unmanaged resource
 		// - reconciler.handleRequeues() is not invoked for delete code path.
 		// TODO: return err as nil when reconciler is updated.
-		return r, requeueWaitWhileDeleting
+		return r, rm.requeueWaitWhileDeleting(r)
 	}
 	if isModifying(r) {
 		// Setting resource synced condition to false will trigger a requeue of
@@ -1413,13 +1467,21 @@ func (rm *resourceManager) sdkDelete(
 		// - reconciler.HandleReconcileError() does not update status for unmanaged resource
 		// - reconciler.handleRequeues() is not invoked for delete code path.
 		// TODO: return err as nil when reconciler is updated.
-		return r, requeueWaitWhileModifying
+		return r, rm.requeueWaitWhileModifying(r)
+	}
+	if err := util.RequeueIfDeletionProtected(r); err != nil {
+		return r, err
+	}
+	if err := validateFinalSnapshot(r); err != nil {
+		return r, err
 	}
 
 	input, err := rm.newDeleteRequestPayload(r)
 	if err != nil {
 		return nil, err
 	}
+	input.FinalSnapshotIdentifier = finalSnapshotIdentifier(r)
+
 	var resp *svcsdk.DeleteCacheClusterOutput
 	_ = resp
 	resp, err = rm.sdkapi.DeleteCacheCluster(ctx, input)
@@ -1437,6 +1499,9 @@ func (rm *resourceManager) newDeleteRequestPayload(
 	if r.ko.Spec.CacheClusterID != nil {
 		res.CacheClusterId = r.ko.Spec.CacheClusterID
 	}
+	if r.ko.Spec.FinalSnapshotIdentifier != nil {
+		res.FinalSnapshotIdentifier = r.ko.Spec.FinalSnapshotIdentifier
+	}
 
 	return res, nil
 }
@@ -20,13 +20,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/elasticache"
 	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-)
 
-const (
-	// The number of minutes worth of events to retrieve.
-	// 14 days in minutes
-	eventsDuration = 20160
+	"github.com/aws-controllers-k8s/elasticache-controller/pkg/util"
 )
 
 func (rm *resourceManager) CustomDescribeCacheSubnetGroupsSetOutput(
@@ -52,47 +47,27 @@ func (rm *resourceManager) customSetOutputSupplementAPIs(
 	subnetGroup *svcsdktypes.CacheSubnetGroup,
 	ko *svcapitypes.CacheSubnetGroup,
 ) error {
-	events, err := rm.provideEvents(ctx, r.ko.Spec.CacheSubnetGroupName, 20)
+	events, err := rm.provideEvents(ctx, r.ko.Spec.CacheSubnetGroupName)
 	if err != nil {
 		return err
 	}
 	ko.Status.Events = events
+	util.RecordEvents(ko, events)
 	return nil
 }
 
 func (rm *resourceManager) provideEvents(
 	ctx context.Context,
 	subnetGroupName *string,
-	maxRecords int64,
 ) ([]*svcapitypes.Event, error) {
-	input := &svcsdk.DescribeEventsInput{}
-	input.SourceType = svcsdktypes.SourceTypeCacheSubnetGroup
-	input.SourceIdentifier = subnetGroupName
-	input.MaxRecords = aws.Int32(int32(maxRecords))
-	input.Duration = aws.Int32(eventsDuration)
-	resp, err := rm.sdkapi.DescribeEvents(ctx, input)
-	rm.metrics.RecordAPICall("READ_MANY", "DescribeEvents-CacheSubnetGroup", err)
+	events, err := util.DescribeEvents(
+		ctx, rm.sdkapi, rm.metrics, rm.awsAccountID, rm.awsRegion,
+		svcsdktypes.SourceTypeCacheSubnetGroup, subnetGroupName,
+	)
 	if err != nil {
 		rm.log.V(1).Info("Error during DescribeEvents-CacheSubnetGroup", "error", err)
 		return nil, err
 	}
-	events := []*svcapitypes.Event{}
-	if resp.Events != nil {
-		for _, respEvent := range resp.Events {
-			event := &svcapitypes.Event{}
-			if respEvent.Message != nil {
-				event.Message = respEvent.Message
-			}
-			if respEvent.Date != nil {
-				eventDate := metav1.NewTime(*respEvent.Date)
-				event.Date = &eventDate
-			}
-			// Not copying redundant source id (replication id)
-			// and source type (replication group)
-			// into each event object
-			events = append(events, event)
-		}
-	}
 	return events, nil
 }
 
@@ -0,0 +1,123 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package global_replication_group
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/elasticache"
+
+	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+)
+
+// unreachableHTTPClient fails every request, so that any ElastiCache API
+// call fails the update.
+type unreachableHTTPClient struct{}
+
+func (unreachableHTTPClient) Do(*http.Request) (*http.Response, error) {
+	return nil, errors.New("unreachable")
+}
+
+func newTestResourceManager() *resourceManager {
+	return &resourceManager{
+		metrics:   ackmetrics.NewMetrics("elasticache"),
+		awsRegion: "us-east-1",
+		sdkapi: svcsdk.New(svcsdk.Options{
+			Region:           "us-east-1",
+			Credentials:      aws.AnonymousCredentials{},
+			HTTPClient:       unreachableHTTPClient{},
+			RetryMaxAttempts: 1,
+		}),
+	}
+}
+
+func newTestMember(region string, id string, role string) *svcapitypes.GlobalReplicationGroupMember {
+	return &svcapitypes.GlobalReplicationGroupMember{
+		ReplicationGroupID:     aws.String(id),
+		ReplicationGroupRegion: aws.String(region),
+		Role:                   aws.String(role),
+		Status:                 aws.String(memberStatusAssociated),
+	}
+}
+
+func newTestGlobalReplicationGroup(
+	members ...*svcapitypes.GlobalReplicationGroupMember,
+) *resource {
+	region := ackv1alpha1.AWSRegion("us-east-1")
+	return &resource{&svcapitypes.GlobalReplicationGroup{
+		Spec: svcapitypes.GlobalReplicationGroupSpec{
+			PrimaryRegion:             aws.String("us-west-2"),
+			PrimaryReplicationGroupID: aws.String("rg-east"),
+			SecondaryReplicationGroups: []*svcapitypes.GlobalReplicationGroupMember{{
+				ReplicationGroupID:     aws.String("rg-west"),
+				ReplicationGroupRegion: aws.String("us-west-2"),
+			}},
+		},
+		Status: svcapitypes.GlobalReplicationGroupStatus{
+			ACKResourceMetadata:      &ackv1alpha1.ResourceMetadata{Region: &region},
+			GlobalReplicationGroupID: aws.String("ldgnf-global"),
+			Members:                  members,
+			Status:                   aws.String(statusAvailable),
+		},
+	}}
+}
+
+func Test_customUpdateGlobalReplicationGroup_afterFailover(t *testing.T) {
+	rm := newTestResourceManager()
+
+	// the primary member is not in the primary region of the spec yet
+	latest := newTestGlobalReplicationGroup(
+		newTestMember("us-east-1", "rg-east", memberRolePrimary),
+		newTestMember("us-west-2", "rg-west", memberRoleSecondary),
+	)
+	desired := &resource{latest.ko.DeepCopy()}
+	if delta := newResourceDelta(desired, latest); !delta.DifferentAt("Spec.PrimaryRegion") {
+		t.Fatalf("delta before failover = %v, want a difference at Spec.PrimaryRegion", delta.Differences)
+	}
+
+	// after the failover, the replication group the Global datastore was
+	// created from is a secondary that is not listed in the spec
+	latest = newTestGlobalReplicationGroup(
+		newTestMember("us-east-1", "rg-east", memberRoleSecondary),
+		newTestMember("us-west-2", "rg-west", memberRolePrimary),
+	)
+	setCurrentPrimaryReplicationGroupID(latest.ko)
+	if got := aws.ToString(latest.ko.Status.CurrentPrimaryReplicationGroupID); got != "rg-west" {
+		t.Errorf("CurrentPrimaryReplicationGroupID = %q, want %q", got, "rg-west")
+	}
+	if delta := newResourceDelta(desired, latest); len(delta.Differences) != 0 {
+		t.Errorf("delta after failover = %v, want no differences", delta.Differences)
+	}
+
+	// the next reconcile does not disassociate it
+	delta := ackcompare.NewDelta()
+	delta.Add("Spec.SecondaryReplicationGroups", desired.ko.Spec.SecondaryReplicationGroups, latest.ko.Status.Members)
+	if _, err := rm.customUpdateGlobalReplicationGroup(context.Background(), desired, latest, delta); err != nil {
+		t.Errorf("customUpdateGlobalReplicationGroup() error = %v, want no member to be disassociated", err)
+	}
+
+	// secondaries that are not expected are still disassociated
+	latest.ko.Status.Members = append(latest.ko.Status.Members,
+		newTestMember("eu-west-1", "rg-eu", memberRoleSecondary))
+	if _, err := rm.customUpdateGlobalReplicationGroup(context.Background(), desired, latest, delta); err == nil {
+		t.Error("customUpdateGlobalReplicationGroup() error = nil, want rg-eu to be disassociated")
+	}
+}
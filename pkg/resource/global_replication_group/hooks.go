@@ -0,0 +1,445 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package global_replication_group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+)
+
+const (
+	statusCreating    = "creating"
+	statusAvailable   = "available"
+	statusPrimaryOnly = "primary-only"
+	statusModifying   = "modifying"
+	statusDeleting    = "deleting"
+)
+
+const (
+	memberRolePrimary      = "PRIMARY"
+	memberRoleSecondary    = "SECONDARY"
+	memberStatusAssociated = "associated"
+)
+
+var (
+	condMsgCurrentlyDeleting = "GlobalReplicationGroup is currently being deleted"
+)
+
+var (
+	requeueWaitWhileDeleting = ackrequeue.NeededAfter(
+		fmt.Errorf("GlobalReplicationGroup is in %q state, it cannot be deleted", statusDeleting),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+	requeueWaitWhileDisassociating = ackrequeue.NeededAfter(
+		errors.New("waiting for secondary replication groups to be disassociated"),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+	requeueWaitWhileFailingOver = ackrequeue.NeededAfter(
+		errors.New("failover of the Global datastore is in progress"),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+)
+
+func hasStatus(r *resource, status string) bool {
+	return r.ko.Status.Status != nil && *r.ko.Status.Status == status
+}
+
+func isCreating(r *resource) bool {
+	return hasStatus(r, statusCreating)
+}
+
+func isModifying(r *resource) bool {
+	return hasStatus(r, statusModifying)
+}
+
+func isDeleting(r *resource) bool {
+	return hasStatus(r, statusDeleting)
+}
+
+// canModify returns true if the Global datastore is in a state that accepts
+// modifications and membership changes
+func canModify(r *resource) bool {
+	return hasStatus(r, statusAvailable) || hasStatus(r, statusPrimaryOnly)
+}
+
+func requeueWaitUntilCanModify(r *resource) *ackrequeue.RequeueNeededAfter {
+	status := ""
+	if r.ko.Status.Status != nil {
+		status = *r.ko.Status.Status
+	}
+	return ackrequeue.NeededAfter(
+		fmt.Errorf("GlobalReplicationGroup in '%s' state, cannot be modified until '%s' or '%s'",
+			status, statusAvailable, statusPrimaryOnly),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+}
+
+// customUpdateGlobalReplicationGroup fails the Global datastore over to
+// Spec.PrimaryRegion, updates its description and disassociates secondary
+// replication groups that are no longer expected members, see
+// expectedMembers.
+func (rm *resourceManager) customUpdateGlobalReplicationGroup(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateGlobalReplicationGroup")
+	defer func() { exit(err) }()
+
+	if !canModify(latest) {
+		return desired, requeueWaitUntilCanModify(latest)
+	}
+
+	ko := desired.ko.DeepCopy()
+	latest.ko.Status.DeepCopyInto(&ko.Status)
+	rm.setStatusDefaults(ko)
+
+	// A failover changes the role of every member, so it is applied on its own
+	// and the remaining changes are picked up once the members have settled.
+	if delta.DifferentAt("Spec.PrimaryRegion") {
+		if !membersSettled(latest) {
+			return &resource{ko}, requeueWaitWhileFailingOver
+		}
+		resp, err := rm.failover(ctx, latest, *desired.ko.Spec.PrimaryRegion)
+		if err != nil {
+			return nil, err
+		}
+		if resp.GlobalReplicationGroup != nil && resp.GlobalReplicationGroup.Status != nil {
+			ko.Status.Status = resp.GlobalReplicationGroup.Status
+		}
+		msg := failoverProgressMessage(desired.ko.Spec.PrimaryRegion, latest)
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+		return &resource{ko}, requeueWaitWhileFailingOver
+	}
+
+	if delta.DifferentAt("Spec.GlobalReplicationGroupDescription") {
+		input := &svcsdk.ModifyGlobalReplicationGroupInput{
+			GlobalReplicationGroupId:          latest.ko.Status.GlobalReplicationGroupID,
+			GlobalReplicationGroupDescription: desired.ko.Spec.GlobalReplicationGroupDescription,
+			ApplyImmediately:                  aws.Bool(true),
+		}
+		resp, err := rm.sdkapi.ModifyGlobalReplicationGroup(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "ModifyGlobalReplicationGroup", err)
+		if err != nil {
+			return nil, err
+		}
+		if resp.GlobalReplicationGroup != nil && resp.GlobalReplicationGroup.Status != nil {
+			ko.Status.Status = resp.GlobalReplicationGroup.Status
+		}
+		if !canModify(&resource{ko}) {
+			// membership changes are picked up once the modification completes
+			return &resource{ko}, requeueWaitUntilCanModify(&resource{ko})
+		}
+	}
+
+	if delta.DifferentAt("Spec.SecondaryReplicationGroups") {
+		if err := validateSecondaryReplicationGroups(desired.ko.Spec.SecondaryReplicationGroups); err != nil {
+			return nil, err
+		}
+		disassociating, err := rm.disassociateSecondaryMembers(ctx, latest, expectedMembers(desired, latest))
+		if err != nil {
+			return nil, err
+		}
+		if disassociating {
+			// the removed members are checked again once they have left
+			return &resource{ko}, requeueWaitWhileDisassociating
+		}
+		if pending := pendingSecondaryMembers(desired.ko.Spec.SecondaryReplicationGroups, latest.ko.Status.Members); len(pending) > 0 {
+			return &resource{ko}, ackrequeue.NeededAfter(
+				fmt.Errorf("waiting for secondary replication groups to join the Global datastore: %s",
+					strings.Join(pending, ", ")),
+				ackrequeue.DefaultRequeueAfterDuration,
+			)
+		}
+	}
+
+	return &resource{ko}, nil
+}
+
+// failover promotes the secondary replication group in the supplied region
+// to be the primary of the Global datastore.
+func (rm *resourceManager) failover(
+	ctx context.Context,
+	r *resource,
+	region string,
+) (*svcsdk.FailoverGlobalReplicationGroupOutput, error) {
+	var target *svcapitypes.GlobalReplicationGroupMember
+	for _, m := range r.ko.Status.Members {
+		if isSecondaryMember(m) && aws.ToString(m.ReplicationGroupRegion) == region {
+			target = m
+			break
+		}
+	}
+	if target == nil {
+		return nil, ackerr.NewTerminalError(fmt.Errorf(
+			"cannot fail over to region %s: the Global datastore has no secondary replication group in that region",
+			region,
+		))
+	}
+	resp, err := rm.sdkapi.FailoverGlobalReplicationGroup(
+		ctx,
+		&svcsdk.FailoverGlobalReplicationGroupInput{
+			GlobalReplicationGroupId:  r.ko.Status.GlobalReplicationGroupID,
+			PrimaryRegion:             aws.String(region),
+			PrimaryReplicationGroupId: target.ReplicationGroupID,
+		},
+	)
+	rm.metrics.RecordAPICall("UPDATE", "FailoverGlobalReplicationGroup", err)
+	return resp, err
+}
+
+// membersSettled returns true if the Global datastore accepts modifications
+// and every member is associated with it.
+func membersSettled(r *resource) bool {
+	if !canModify(r) {
+		return false
+	}
+	for _, m := range r.ko.Status.Members {
+		if m.Status == nil || *m.Status != memberStatusAssociated {
+			return false
+		}
+	}
+	return true
+}
+
+// failoverProgressMessage describes the state of a failover towards the
+// requested primary region. It returns an empty string once the primary member
+// resides in that region and all members have settled.
+func failoverProgressMessage(primaryRegion *string, r *resource) string {
+	if primaryRegion == nil {
+		return ""
+	}
+	primary := primaryMember(r.ko.Status.Members)
+	if primary != nil && aws.ToString(primary.ReplicationGroupRegion) == *primaryRegion && membersSettled(r) {
+		return ""
+	}
+	members := []string{}
+	for _, m := range r.ko.Status.Members {
+		members = append(members, fmt.Sprintf("%s %s %s",
+			memberKey(m), aws.ToString(m.Role), aws.ToString(m.Status)))
+	}
+	return fmt.Sprintf(
+		"failover to %s in progress: Global datastore is %s; members: %s",
+		*primaryRegion, aws.ToString(r.ko.Status.Status), strings.Join(members, ", "),
+	)
+}
+
+// disassociateSecondaryMembers removes every secondary member of the Global
+// datastore that is not present in keep. It returns true if any of those
+// secondary members is still part of the Global datastore.
+func (rm *resourceManager) disassociateSecondaryMembers(
+	ctx context.Context,
+	r *resource,
+	keep []*svcapitypes.GlobalReplicationGroupMember,
+) (bool, error) {
+	wanted := map[string]struct{}{}
+	for _, m := range keep {
+		wanted[memberKey(m)] = struct{}{}
+	}
+	remaining := false
+	for _, m := range r.ko.Status.Members {
+		if !isSecondaryMember(m) {
+			continue
+		}
+		if _, ok := wanted[memberKey(m)]; ok {
+			continue
+		}
+		remaining = true
+		// members that are already associating or disassociating cannot be
+		// disassociated until they settle
+		if m.Status == nil || *m.Status != memberStatusAssociated {
+			continue
+		}
+		_, err := rm.sdkapi.DisassociateGlobalReplicationGroup(
+			ctx,
+			&svcsdk.DisassociateGlobalReplicationGroupInput{
+				GlobalReplicationGroupId: r.ko.Status.GlobalReplicationGroupID,
+				ReplicationGroupId:       m.ReplicationGroupID,
+				ReplicationGroupRegion:   m.ReplicationGroupRegion,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "DisassociateGlobalReplicationGroup", err)
+		if err != nil {
+			return remaining, err
+		}
+	}
+	return remaining, nil
+}
+
+// validateSecondaryReplicationGroups returns a terminal error if a desired
+// secondary replication group does not name both its replication group and
+// its region, as members are matched on both.
+func validateSecondaryReplicationGroups(
+	members []*svcapitypes.GlobalReplicationGroupMember,
+) error {
+	for _, m := range members {
+		if m == nil || m.ReplicationGroupID == nil || m.ReplicationGroupRegion == nil {
+			return ackerr.NewTerminalError(errors.New(
+				"each of Spec.SecondaryReplicationGroups must set replicationGroupID and replicationGroupRegion",
+			))
+		}
+	}
+	return nil
+}
+
+// pendingSecondaryMembers returns the desired secondary replication groups
+// that are not members of the Global datastore yet. A desired secondary that
+// became the primary after a failover is a member.
+func pendingSecondaryMembers(
+	desired []*svcapitypes.GlobalReplicationGroupMember,
+	members []*svcapitypes.GlobalReplicationGroupMember,
+) []string {
+	current := map[string]struct{}{}
+	for _, m := range members {
+		if m != nil {
+			current[memberKey(m)] = struct{}{}
+		}
+	}
+	pending := []string{}
+	for _, m := range desired {
+		if _, ok := current[memberKey(m)]; !ok {
+			pending = append(pending, memberKey(m))
+		}
+	}
+	return pending
+}
+
+// modifyDelta adds the differences that cannot be found by comparing the
+// desired and latest specs, as the service reports them in Status.Members.
+func modifyDelta(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	comparePrimaryRegion(delta, desired, latest)
+	compareSecondaryReplicationGroups(delta, desired, latest)
+}
+
+// comparePrimaryRegion compares the desired primary region with the region of
+// the current primary member.
+func comparePrimaryRegion(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	if desired.ko.Spec.PrimaryRegion == nil {
+		return
+	}
+	var observed *string
+	if primary := primaryMember(latest.ko.Status.Members); primary != nil {
+		observed = primary.ReplicationGroupRegion
+	}
+	if observed == nil || *observed != *desired.ko.Spec.PrimaryRegion {
+		delta.Add("Spec.PrimaryRegion", desired.ko.Spec.PrimaryRegion, observed)
+	}
+}
+
+// compareSecondaryReplicationGroups compares the expected members of the
+// Global datastore with the members reported by the service, whatever their
+// role. The list is only managed when it is set in the desired spec.
+func compareSecondaryReplicationGroups(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	if desired.ko.Spec.SecondaryReplicationGroups == nil {
+		return
+	}
+	wanted := map[string]struct{}{}
+	for _, m := range expectedMembers(desired, latest) {
+		wanted[memberKey(m)] = struct{}{}
+	}
+	observed := map[string]struct{}{}
+	for _, m := range latest.ko.Status.Members {
+		if m != nil {
+			observed[memberKey(m)] = struct{}{}
+		}
+	}
+	if len(wanted) != len(observed) {
+		delta.Add("Spec.SecondaryReplicationGroups", desired.ko.Spec.SecondaryReplicationGroups, latest.ko.Status.Members)
+		return
+	}
+	for k := range wanted {
+		if _, ok := observed[k]; !ok {
+			delta.Add("Spec.SecondaryReplicationGroups", desired.ko.Spec.SecondaryReplicationGroups, latest.ko.Status.Members)
+			return
+		}
+	}
+}
+
+// expectedMembers returns the replication groups expected to be members of the
+// Global datastore: Spec.SecondaryReplicationGroups and the replication group
+// the Global datastore was created from, in the region of the resource. A
+// failover swaps the roles of the members, so the replication group it was
+// created from stays a member when it becomes a secondary. It returns nil when
+// membership is not managed.
+func expectedMembers(
+	desired *resource,
+	latest *resource,
+) []*svcapitypes.GlobalReplicationGroupMember {
+	if desired.ko.Spec.SecondaryReplicationGroups == nil {
+		return nil
+	}
+	members := append([]*svcapitypes.GlobalReplicationGroupMember{}, desired.ko.Spec.SecondaryReplicationGroups...)
+	metadata := latest.ko.Status.ACKResourceMetadata
+	if desired.ko.Spec.PrimaryReplicationGroupID != nil && metadata != nil && metadata.Region != nil {
+		members = append(members, &svcapitypes.GlobalReplicationGroupMember{
+			ReplicationGroupID:     desired.ko.Spec.PrimaryReplicationGroupID,
+			ReplicationGroupRegion: aws.String(string(*metadata.Region)),
+		})
+	}
+	return members
+}
+
+// setCurrentPrimaryReplicationGroupID reports the replication group that is
+// the primary of the Global datastore, which changes with every failover.
+func setCurrentPrimaryReplicationGroupID(ko *svcapitypes.GlobalReplicationGroup) {
+	ko.Status.CurrentPrimaryReplicationGroupID = nil
+	if primary := primaryMember(ko.Status.Members); primary != nil {
+		ko.Status.CurrentPrimaryReplicationGroupID = primary.ReplicationGroupID
+	}
+}
+
+func primaryMember(members []*svcapitypes.GlobalReplicationGroupMember) *svcapitypes.GlobalReplicationGroupMember {
+	for _, m := range members {
+		if m != nil && m.Role != nil && strings.EqualFold(*m.Role, memberRolePrimary) {
+			return m
+		}
+	}
+	return nil
+}
+
+func isSecondaryMember(m *svcapitypes.GlobalReplicationGroupMember) bool {
+	return m != nil && m.Role != nil && strings.EqualFold(*m.Role, memberRoleSecondary)
+}
+
+// memberKey identifies a member by its region and replication group ID
+func memberKey(m *svcapitypes.GlobalReplicationGroupMember) string {
+	return fmt.Sprintf("%s/%s", aws.ToString(m.ReplicationGroupRegion), aws.ToString(m.ReplicationGroupID))
+}
@@ -125,6 +125,16 @@ func newResourceDelta(
 			delta.Add("Spec.EngineVersion", a.ko.Spec.EngineVersion, b.ko.Spec.EngineVersion)
 		}
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.GlobalReplicationGroupID, b.ko.Spec.GlobalReplicationGroupID) {
+		delta.Add("Spec.GlobalReplicationGroupID", a.ko.Spec.GlobalReplicationGroupID, b.ko.Spec.GlobalReplicationGroupID)
+	} else if a.ko.Spec.GlobalReplicationGroupID != nil && b.ko.Spec.GlobalReplicationGroupID != nil {
+		if *a.ko.Spec.GlobalReplicationGroupID != *b.ko.Spec.GlobalReplicationGroupID {
+			delta.Add("Spec.GlobalReplicationGroupID", a.ko.Spec.GlobalReplicationGroupID, b.ko.Spec.GlobalReplicationGroupID)
+		}
+	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.GlobalReplicationGroupRef, b.ko.Spec.GlobalReplicationGroupRef) {
+		delta.Add("Spec.GlobalReplicationGroupRef", a.ko.Spec.GlobalReplicationGroupRef, b.ko.Spec.GlobalReplicationGroupRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.IPDiscovery, b.ko.Spec.IPDiscovery) {
 		delta.Add("Spec.IPDiscovery", a.ko.Spec.IPDiscovery, b.ko.Spec.IPDiscovery)
 	} else if a.ko.Spec.IPDiscovery != nil && b.ko.Spec.IPDiscovery != nil {
@@ -218,6 +228,16 @@ func newResourceDelta(
 	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.SecurityGroupRefs, b.ko.Spec.SecurityGroupRefs) {
 		delta.Add("Spec.SecurityGroupRefs", a.ko.Spec.SecurityGroupRefs, b.ko.Spec.SecurityGroupRefs)
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.ServerlessCacheSnapshotName, b.ko.Spec.ServerlessCacheSnapshotName) {
+		delta.Add("Spec.ServerlessCacheSnapshotName", a.ko.Spec.ServerlessCacheSnapshotName, b.ko.Spec.ServerlessCacheSnapshotName)
+	} else if a.ko.Spec.ServerlessCacheSnapshotName != nil && b.ko.Spec.ServerlessCacheSnapshotName != nil {
+		if *a.ko.Spec.ServerlessCacheSnapshotName != *b.ko.Spec.ServerlessCacheSnapshotName {
+			delta.Add("Spec.ServerlessCacheSnapshotName", a.ko.Spec.ServerlessCacheSnapshotName, b.ko.Spec.ServerlessCacheSnapshotName)
+		}
+	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.ServerlessCacheSnapshotRef, b.ko.Spec.ServerlessCacheSnapshotRef) {
+		delta.Add("Spec.ServerlessCacheSnapshotRef", a.ko.Spec.ServerlessCacheSnapshotRef, b.ko.Spec.ServerlessCacheSnapshotRef)
+	}
 	if len(a.ko.Spec.SnapshotARNs) != len(b.ko.Spec.SnapshotARNs) {
 		delta.Add("Spec.SnapshotARNs", a.ko.Spec.SnapshotARNs, b.ko.Spec.SnapshotARNs)
 	} else if len(a.ko.Spec.SnapshotARNs) > 0 {
@@ -21,20 +21,26 @@ import (
 	"reflect"
 	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/elasticache"
 	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	smithy "github.com/aws/smithy-go"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
 	"github.com/aws-controllers-k8s/elasticache-controller/pkg/common"
 	"github.com/aws-controllers-k8s/elasticache-controller/pkg/util"
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
-	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
 )
 
@@ -49,6 +55,18 @@ const (
 	// AnnotationLastRequestedNNG is an annotation whose value is passed in as input to either the create or modify API
 	// called most recently
 	AnnotationLastRequestedNNG = svcapitypes.AnnotationPrefix + "last-requested-num-node-groups"
+	// AnnotationLastRequestedAuthToken is an annotation whose value is the version of the secret holding the
+	// AUTH token passed in as input to either the create or modify API called most recently
+	AnnotationLastRequestedAuthToken = svcapitypes.AnnotationPrefix + "last-requested-auth-token-secret-version"
+	// AnnotationLastRequestedAuthTokenUpdateStrategy is an annotation whose value is the AUTH token update
+	// strategy passed in as input to the modify API called most recently
+	AnnotationLastRequestedAuthTokenUpdateStrategy = svcapitypes.AnnotationPrefix + "last-requested-auth-token-update-strategy"
+	// annotationObservedAuthToken is an annotation set on the latest observed resource only, whose value is the
+	// version of the secret currently referenced by the AUTH token
+	annotationObservedAuthToken = svcapitypes.AnnotationPrefix + "observed-auth-token-secret-version"
+	// AnnotationConfirmClusterModeEnabled is an annotation which has to be set to true before the cluster mode
+	// is changed from compatible to enabled, confirming that all clients are cluster-aware
+	AnnotationConfirmClusterModeEnabled = svcapitypes.AnnotationPrefix + "confirm-cluster-mode-enabled"
 	// AnnotationLastRequestedNGC is an annotation whose value is the marshaled list of pointers to
 	// NodeGroupConfiguration structs passed in as input to either the create or modify API called most
 	// recently
@@ -61,6 +79,28 @@ var (
 	condMsgNoDeleteWhileModifying string = "replication group currently being modified. cannot delete."
 	condMsgTerminalCreateFailed   string = "replication group in create-failed status."
 	condMsgDurabilityModifying    string = "replication group durability update in progress."
+
+	condMsgJoiningGlobalReplicationGroup string = "replication group is not yet a member of the requested global replication group."
+
+	condMsgClusterModeAwaitingConfirmation = fmt.Sprintf("cluster mode is compatible, set the %s annotation "+
+		"to true once all clients are cluster-aware to enable it.", AnnotationConfirmClusterModeEnabled)
+)
+
+// In-transit encryption states of a replication group, in migration order.
+// Each migration step moves a replication group to a neighbouring state.
+const (
+	transitEncryptionDisabled  string = "disabled"
+	transitEncryptionPreferred string = string(svcsdktypes.TransitEncryptionModePreferred)
+	transitEncryptionRequired  string = string(svcsdktypes.TransitEncryptionModeRequired)
+)
+
+// In-transit encryption migration phases recorded in
+// Status.TransitEncryptionMigrationPhase.
+const (
+	transitEncryptionPhaseEnablingPreferred   string = "enabling-preferred"
+	transitEncryptionPhaseEnforcingRequired   string = "enforcing-required"
+	transitEncryptionPhaseRelaxingToPreferred string = "relaxing-to-preferred"
+	transitEncryptionPhaseDisabling           string = "disabling"
 )
 
 const (
@@ -70,16 +110,23 @@ const (
 	statusCreateFailed string = "create-failed"
 )
 
-var (
-	requeueWaitWhileDeleting = ackrequeue.NeededAfter(
-		errors.New("Delete is in progress."),
-		ackrequeue.DefaultRequeueAfterDuration,
-	)
-	requeueWaitWhileModifying = ackrequeue.NeededAfter(
-		errors.New("Modify is in progress."),
-		ackrequeue.DefaultRequeueAfterDuration,
-	)
-)
+// requeueWaitWhile returns the requeue error of a replication group waiting
+// for the transition to finish.
+func (rm *resourceManager) requeueWaitWhile(
+	r *resource,
+	transition util.Transition,
+	err error,
+) error {
+	return util.RequeueWaitWhile(err, "ReplicationGroup", r.ko, transition, rm.awsAccountID, rm.awsRegion)
+}
+
+func (rm *resourceManager) requeueWaitWhileDeleting(r *resource) error {
+	return rm.requeueWaitWhile(r, util.TransitionDeleting, errors.New("Delete is in progress."))
+}
+
+func (rm *resourceManager) requeueWaitWhileModifying(r *resource) error {
+	return rm.requeueWaitWhile(r, util.TransitionModifying, errors.New("Modify is in progress."))
+}
 
 // isDeleting returns true if supplied replication group resource state is 'deleting'
 func isDeleting(r *resource) bool {
@@ -118,6 +165,153 @@ func isCreateFailed(r *resource) bool {
 	return status == statusCreateFailed
 }
 
+// joiningGlobalReplicationGroup returns true if the replication group was
+// requested to join a global replication group that lists it as a member, but
+// its status does not report that membership yet. A replication group that is
+// no longer listed, because the Global datastore disassociated it or was
+// deleted, remains a standalone replication group and is not reported as
+// joining.
+func (rm *resourceManager) joiningGlobalReplicationGroup(
+	ctx context.Context,
+	r *resource,
+) (bool, error) {
+	globalReplicationGroupID := r.ko.Spec.GlobalReplicationGroupID
+	if globalReplicationGroupID == nil {
+		return false, nil
+	}
+	info := r.ko.Status.GlobalReplicationGroupInfo
+	if info != nil && info.GlobalReplicationGroupID != nil &&
+		*info.GlobalReplicationGroupID == *globalReplicationGroupID {
+		return false, nil
+	}
+	resp, err := rm.sdkapi.DescribeGlobalReplicationGroups(ctx, &svcsdk.DescribeGlobalReplicationGroupsInput{
+		GlobalReplicationGroupId: globalReplicationGroupID,
+		ShowMemberInfo:           aws.Bool(true),
+	})
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeGlobalReplicationGroups", err)
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "GlobalReplicationGroupNotFoundFault" {
+			return false, nil
+		}
+		return false, err
+	}
+	for _, grg := range resp.GlobalReplicationGroups {
+		for _, m := range grg.Members {
+			if aws.ToString(m.ReplicationGroupId) == aws.ToString(r.ko.Spec.ReplicationGroupID) &&
+				aws.ToString(m.ReplicationGroupRegion) == string(rm.awsRegion) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// finalSnapshotIdentifier returns the name of the snapshot to take when the
+// replication group is deleted, or nil if no final snapshot was requested. A
+// generated name is derived from the deletion timestamp of the resource so
+// that it does not change between reconciliations.
+func finalSnapshotIdentifier(r *resource) *string {
+	if r.ko.Spec.FinalSnapshotIdentifier != nil {
+		return r.ko.Spec.FinalSnapshotIdentifier
+	}
+	if r.ko.Spec.CreateFinalSnapshot == nil || !*r.ko.Spec.CreateFinalSnapshot {
+		return nil
+	}
+	deletedAt := time.Now()
+	if r.ko.DeletionTimestamp != nil {
+		deletedAt = r.ko.DeletionTimestamp.Time
+	}
+	name := fmt.Sprintf(
+		"%s-final-%s",
+		*r.ko.Spec.ReplicationGroupID,
+		deletedAt.UTC().Format("20060102-150405"),
+	)
+	return &name
+}
+
+// adoptFinalSnapshot creates a Snapshot resource that adopts the final
+// snapshot of a replication group being deleted, if AdoptFinalSnapshot is
+// set. A Snapshot resource that already exists is left alone. The Snapshot
+// resource retains the snapshot when it is deleted, so that deleting it or
+// its namespace does not destroy the final snapshot.
+//
+// It is called on every read of a replication group marked for deletion, and
+// an error fails the read. Once the replication group is deleting, reads are
+// requeued until ElastiCache reports the final snapshot, so the finalizer is
+// only removed once the final snapshot has been adopted.
+func (rm *resourceManager) adoptFinalSnapshot(
+	ctx context.Context,
+	r *resource,
+) error {
+	if r.ko.DeletionTimestamp == nil ||
+		r.ko.Spec.AdoptFinalSnapshot == nil || !*r.ko.Spec.AdoptFinalSnapshot {
+		return nil
+	}
+	snapshotName := finalSnapshotIdentifier(r)
+	if snapshotName == nil {
+		return nil
+	}
+	kc := util.KubeClient()
+	if kc == nil {
+		return errors.New("no Kubernetes client available to adopt the final snapshot")
+	}
+	key := types.NamespacedName{
+		Namespace: r.ko.Namespace,
+		Name:      strings.ToLower(*snapshotName),
+	}
+	if err := kc.Get(ctx, key, &svcapitypes.Snapshot{}); err == nil {
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("unable to adopt final snapshot %s: %w", *snapshotName, err)
+	}
+
+	resp, err := rm.sdkapi.DescribeSnapshots(ctx, &svcsdk.DescribeSnapshotsInput{
+		SnapshotName: snapshotName,
+	})
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeSnapshots", err)
+	if err != nil {
+		var awsErr smithy.APIError
+		if !errors.As(err, &awsErr) || awsErr.ErrorCode() != "SnapshotNotFoundFault" {
+			return fmt.Errorf("unable to adopt final snapshot %s: %w", *snapshotName, err)
+		}
+	}
+	if err != nil || len(resp.Snapshots) == 0 {
+		if !isDeleting(r) {
+			// The final snapshot is only taken once the replication group
+			// is being deleted.
+			return nil
+		}
+		return rm.requeueWaitWhile(r, util.TransitionDeleting,
+			fmt.Errorf("final snapshot %s is not yet available for adoption", *snapshotName))
+	}
+
+	adoptionFields, err := json.Marshal(map[string]string{
+		"snapshotName": *snapshotName,
+	})
+	if err != nil {
+		return err
+	}
+	snapshot := &svcapitypes.Snapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+			Annotations: map[string]string{
+				ackv1alpha1.AnnotationAdoptionPolicy: "adopt",
+				ackv1alpha1.AnnotationAdoptionFields: string(adoptionFields),
+				ackv1alpha1.AnnotationDeletionPolicy: string(ackv1alpha1.DeletionPolicyRetain),
+			},
+		},
+		Spec: svcapitypes.SnapshotSpec{
+			SnapshotName: snapshotName,
+		},
+	}
+	if err := kc.Create(ctx, snapshot); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("unable to adopt final snapshot %s: %w", *snapshotName, err)
+	}
+	return nil
+}
+
 // getTags retrieves the resource's associated tags.
 func (rm *resourceManager) getTags(
 	ctx context.Context,
@@ -135,11 +329,39 @@ func (rm *resourceManager) syncTags(
 	return util.SyncTags(ctx, desired.ko.Spec.Tags, latest.ko.Spec.Tags, latest.ko.Status.ACKResourceMetadata, convertToOrderedACKTags, rm.sdkapi, rm.metrics)
 }
 
-const (
-	// The number of minutes worth of events to retrieve.
-	// 14 days in minutes
-	eventsDuration = 20160
-)
+// setPendingUpdateActions reports the service updates not applied to the
+// replication group yet. Spec.ServiceUpdateName only selects the one that is
+// applied, every pending update is reported.
+func (rm *resourceManager) setPendingUpdateActions(
+	ctx context.Context,
+	ko *svcapitypes.ReplicationGroup,
+) error {
+	updateActions, err := util.GetPendingUpdateActions(ctx, rm.sdkapi, rm.metrics, ko.Spec.ReplicationGroupID, nil)
+	if err != nil {
+		return err
+	}
+	ko.Status.PendingUpdateActions = updateActions
+	return nil
+}
+
+// syncServiceUpdate applies or stops the service update requested in
+// Spec.ServiceUpdateName.
+func (rm *resourceManager) syncServiceUpdate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) error {
+	return util.SyncServiceUpdate(
+		ctx,
+		rm.sdkapi,
+		rm.metrics,
+		desired.ko.Spec.ServiceUpdateName,
+		desired.ko.Spec.ServiceUpdateWindow,
+		latest.ko.Status.PendingUpdateActions,
+		latest.ko.Spec.ReplicationGroupID,
+		nil,
+	)
+}
 
 func (rm *resourceManager) CustomDescribeReplicationGroupsSetOutput(
 	ctx context.Context,
@@ -169,6 +391,7 @@ func (rm *resourceManager) CustomCreateReplicationGroupSetOutput(
 	rm.setAnnotationsFields(r, ko)
 	rm.setLastRequestedNodeGroupConfiguration(r, ko)
 	rm.setLastRequestedNumNodeGroups(r, ko)
+	rm.setLastRequestedAuthToken(ctx, r, ko)
 	return ko, nil
 }
 
@@ -238,6 +461,9 @@ func (rm *resourceManager) customSetOutput(
 			syncConditionStatus = corev1.ConditionFalse
 		}
 	}
+	if syncConditionStatus == corev1.ConditionTrue {
+		util.EndTransition("ReplicationGroup", ko)
+	}
 
 	var resourceSyncedCondition *ackv1alpha1.Condition = nil
 	for _, condition := range ko.Status.Conditions {
@@ -338,47 +564,65 @@ func (rm *resourceManager) customSetOutputSupplementAPIs(
 	respRG *svcsdktypes.ReplicationGroup,
 	ko *svcapitypes.ReplicationGroup,
 ) error {
-	events, err := rm.provideEvents(ctx, r.ko.Spec.ReplicationGroupID, 20)
+	events, err := rm.provideEvents(ctx, r.ko.Spec.ReplicationGroupID)
 	if err != nil {
 		return err
 	}
 	ko.Status.Events = events
+	util.RecordEvents(ko, events)
+
+	if err := rm.setPendingUpdateActions(ctx, ko); err != nil {
+		return err
+	}
+
+	rm.setObservedAuthToken(ctx, r, ko)
+	setTransitEncryptionMigrationPhase(r, ko)
+	util.ForgetDeletedConnectionSecret(ctx, ko, ko.Spec.ConnectionSecret)
 	return nil
 }
 
+// connectionDetails returns the connection details of the replication group,
+// with the endpoints and AUTH token version observed in latest. Cluster mode
+// enabled replication groups are reached through their configuration
+// endpoint, others through the primary and reader endpoints of their node
+// group.
+func connectionDetails(desired *resource, latest *resource) util.ConnectionDetails {
+	details := util.ConnectionDetails{
+		TLS:              desired.ko.Spec.TransitEncryptionEnabled != nil && *desired.ko.Spec.TransitEncryptionEnabled,
+		AuthToken:        desired.ko.Spec.AuthToken,
+		AuthTokenVersion: latest.ko.GetAnnotations()[annotationObservedAuthToken],
+	}
+	if latest.ko.Status.ConfigurationEndpoint != nil {
+		details.Endpoint = latest.ko.Status.ConfigurationEndpoint
+	} else if len(latest.ko.Status.NodeGroups) > 0 && latest.ko.Status.NodeGroups[0] != nil {
+		details.Endpoint = latest.ko.Status.NodeGroups[0].PrimaryEndpoint
+		details.ReaderEndpoint = latest.ko.Status.NodeGroups[0].ReaderEndpoint
+	}
+	return details
+}
+
+// applyImmediately returns whether modifications are applied as soon as
+// possible rather than during the next maintenance window, which is the
+// default.
+func applyImmediately(r *resource) *bool {
+	if r.ko.Spec.ApplyImmediately == nil {
+		return aws.Bool(true)
+	}
+	return r.ko.Spec.ApplyImmediately
+}
+
 func (rm *resourceManager) provideEvents(
 	ctx context.Context,
 	replicationGroupId *string,
-	maxRecords int64,
 ) ([]*svcapitypes.Event, error) {
-	input := &svcsdk.DescribeEventsInput{}
-	input.SourceType = svcsdktypes.SourceTypeReplicationGroup
-	input.SourceIdentifier = replicationGroupId
-	input.MaxRecords = aws.Int32(int32(maxRecords))
-	input.Duration = aws.Int32(eventsDuration)
-	resp, err := rm.sdkapi.DescribeEvents(ctx, input)
-	rm.metrics.RecordAPICall("READ_MANY", "DescribeEvents-ReplicationGroup", err)
+	events, err := util.DescribeEvents(
+		ctx, rm.sdkapi, rm.metrics, rm.awsAccountID, rm.awsRegion,
+		svcsdktypes.SourceTypeReplicationGroup, replicationGroupId,
+	)
 	if err != nil {
 		rm.log.V(1).Info("Error during DescribeEvents-ReplicationGroup", "error", err)
 		return nil, err
 	}
-	events := []*svcapitypes.Event{}
-	if resp.Events != nil {
-		for _, respEvent := range resp.Events {
-			event := &svcapitypes.Event{}
-			if respEvent.Message != nil {
-				event.Message = respEvent.Message
-			}
-			if respEvent.Date != nil {
-				eventDate := metav1.NewTime(*respEvent.Date)
-				event.Date = &eventDate
-			}
-			// Not copying redundant source id (replication id)
-			// and source type (replication group)
-			// into each event object
-			events = append(events, event)
-		}
-	}
 	return events, nil
 }
 
@@ -471,6 +715,57 @@ func (rm *resourceManager) setLastRequestedNumNodeGroups(
 	}
 }
 
+// authTokenVersion returns the version of the secret referenced by the
+// AUTH token of the supplied replication group, or an empty string if it
+// references none. The version is a salted hash of the token, which cannot be
+// read back from the recorded version.
+func (rm *resourceManager) authTokenVersion(
+	ctx context.Context,
+	r *resource,
+) (string, error) {
+	if r.ko.Spec.AuthToken == nil {
+		return "", nil
+	}
+	return util.SecretVersion(ctx, r.ko.Spec.AuthToken, r.ko.Namespace)
+}
+
+// setLastRequestedAuthToken records the version of the secret holding the
+// AUTH token the replication group was created with, so that later changes
+// to the secret are detected.
+func (rm *resourceManager) setLastRequestedAuthToken(
+	ctx context.Context,
+	r *resource,
+	ko *svcapitypes.ReplicationGroup,
+) {
+	version, err := rm.authTokenVersion(ctx, r)
+	if err != nil || version == "" {
+		return
+	}
+	annotations := getAnnotationsFields(r, ko)
+	annotations[AnnotationLastRequestedAuthToken] = version
+}
+
+// setObservedAuthToken records the version of the secret currently
+// referenced by the AUTH token on the latest observed resource. A secret that
+// cannot be read is logged and skipped, so that it never blocks reads of the
+// replication group.
+func (rm *resourceManager) setObservedAuthToken(
+	ctx context.Context,
+	r *resource,
+	ko *svcapitypes.ReplicationGroup,
+) {
+	version, err := rm.authTokenVersion(ctx, r)
+	if err != nil {
+		rm.log.V(1).Info("unable to read AUTH token secret", "error", err)
+		return
+	}
+	if version == "" {
+		return
+	}
+	annotations := getAnnotationsFields(r, ko)
+	annotations[annotationObservedAuthToken] = version
+}
+
 // Implements specialized logic for replication group updates.
 func (rm *resourceManager) CustomModifyReplicationGroup(
 	ctx context.Context,
@@ -499,9 +794,9 @@ func (rm *resourceManager) CustomModifyReplicationGroup(
 	}
 
 	if latestRGStatus == nil || *latestRGStatus != "available" || !allNodeGroupsAvailable {
-		return nil, requeue.NeededAfter(
-			errors.New("Replication Group can not be modified, it is not in 'available' state."),
-			requeue.DefaultRequeueAfterDuration)
+		return nil, rm.requeueWaitWhile(
+			latest, util.TransitionFromStatus(latestRGStatus),
+			errors.New("Replication Group can not be modified, it is not in 'available' state."))
 	}
 
 	memberClustersCount := 0
@@ -509,10 +804,31 @@ func (rm *resourceManager) CustomModifyReplicationGroup(
 		memberClustersCount = len(latest.ko.Status.MemberClusters)
 	}
 	if memberClustersCount != nodeGroupMembersCount {
-		return nil, requeue.NeededAfter(
+		return nil, rm.requeueWaitWhile(
+			latest, util.TransitionModifying,
 			errors.New("Replication Group can not be modified, "+
-				"need to wait for member clusters and node group members."),
-			requeue.DefaultRequeueAfterDuration)
+				"need to wait for member clusters and node group members."))
+	}
+
+	if delta.DifferentAt("Spec.AuthToken") && authTokenRequiresUpdate(desired, latest) {
+		return rm.updateAuthToken(ctx, desired, latest)
+	}
+
+	if transitEncryptionRequiresUpdate(desired, latest) {
+		return rm.migrateTransitEncryption(ctx, desired, latest)
+	}
+
+	if clusterModeRequiresUpdate(desired, latest) {
+		if !clusterModeAwaitingConfirmation(desired, latest) {
+			return rm.migrateClusterMode(ctx, desired, latest)
+		}
+		// Only the step to the enabled cluster mode waits for the
+		// confirmation, the other differences are applied meanwhile.
+		common.RemoveFromDelta(delta, "Spec.ClusterMode")
+		if !delta.DifferentExcept("Spec.Tags") {
+			ackcondition.SetSynced(desired, corev1.ConditionFalse, &condMsgClusterModeAwaitingConfirmation, nil)
+			return desired, nil
+		}
 	}
 
 	// Handle the asynchronous rollback case for while Scaling down.
@@ -1163,7 +1479,7 @@ func (rm *resourceManager) newModifyReplicationGroupRequestPayload(
 ) *svcsdk.ModifyReplicationGroupInput {
 	input := &svcsdk.ModifyReplicationGroupInput{}
 
-	input.ApplyImmediately = aws.Bool(true)
+	input.ApplyImmediately = applyImmediately(desired)
 	if desired.ko.Spec.ReplicationGroupID != nil {
 		input.ReplicationGroupId = desired.ko.Spec.ReplicationGroupID
 	}
@@ -1263,6 +1579,7 @@ func (rm *resourceManager) updateSpecFields(
 		setEngineVersion(latestCacheCluster, resource)
 		setMaintenanceWindow(latestCacheCluster, resource)
 		setCacheParameterGroup(latestCacheCluster, resource)
+		setPendingModifiedValues(latestCacheCluster, resource)
 	}
 }
 
@@ -1345,6 +1662,26 @@ func setEngineVersion(
 	}
 }
 
+// setPendingModifiedValues sets the CacheNodeType and EngineVersion changes
+// deferred to the maintenance window on ko.Spec, so that they are not
+// requested again. The replication group does not report them, they are read
+// from the member cluster already described for the other Spec fields.
+func setPendingModifiedValues(
+	latestCacheCluster *svcsdktypes.CacheCluster,
+	resource *resource,
+) {
+	ko := resource.ko
+	if *applyImmediately(resource) || latestCacheCluster.PendingModifiedValues == nil {
+		return
+	}
+	if latestCacheCluster.PendingModifiedValues.CacheNodeType != nil {
+		ko.Spec.CacheNodeType = latestCacheCluster.PendingModifiedValues.CacheNodeType
+	}
+	if latestCacheCluster.PendingModifiedValues.EngineVersion != nil {
+		ko.Spec.EngineVersion = latestCacheCluster.PendingModifiedValues.EngineVersion
+	}
+}
+
 // update maintenance window (if non-nil in API response) regardless of whether it was specified in desired
 func setMaintenanceWindow(
 	latestCacheCluster *svcsdktypes.CacheCluster,
@@ -1413,6 +1750,324 @@ func modifyDelta(
 	if updateRequired, current := primaryClusterIDRequiresUpdate(desired, latest); updateRequired {
 		delta.Add("Spec.PrimaryClusterID", desired.ko.Spec.PrimaryClusterID, *current)
 	}
+
+	if util.ServiceUpdateActionRequired(desired.ko.Spec.ServiceUpdateName, desired.ko.Spec.ServiceUpdateWindow,
+		latest.ko.Status.PendingUpdateActions) {
+		delta.Add("Spec.ServiceUpdateName", desired.ko.Spec.ServiceUpdateName, nil)
+	}
+
+	// TransitEncryptionEnabled and TransitEncryptionMode are compared together,
+	// as a single in-transit encryption state
+	common.RemoveFromDelta(delta, "Spec.TransitEncryptionEnabled")
+	if transitEncryptionRequiresUpdate(desired, latest) {
+		delta.Add("Spec.TransitEncryptionMode", desired.ko.Spec.TransitEncryptionMode,
+			latest.ko.Spec.TransitEncryptionMode)
+	}
+
+	if clusterModeRequiresUpdate(desired, latest) {
+		delta.Add("Spec.ClusterMode", desired.ko.Spec.ClusterMode, latest.ko.Spec.ClusterMode)
+	}
+
+	if util.ConnectionSecretOutdated(latest.ko, desired.ko.Spec.ConnectionSecret, connectionDetails(desired, latest)) {
+		delta.Add("Spec.ConnectionSecret", desired.ko.Spec.ConnectionSecret, nil)
+	}
+
+	// A ROTATE is only finalized once every other difference is resolved, so
+	// that waiting for its grace period does not hold back other changes.
+	if authTokenChanged(desired, latest) ||
+		(authTokenRotationPending(desired, latest) && !delta.DifferentExcept(nonBlockingDifferences(desired, latest)...)) {
+		delta.Add("Spec.AuthToken", desired.ko.Spec.AuthToken, nil)
+	}
+}
+
+// authTokenRequiresUpdate returns true if the AUTH token changed or a ROTATE
+// still has to be finalized.
+func authTokenRequiresUpdate(desired *resource, latest *resource) bool {
+	return authTokenChanged(desired, latest) || authTokenRotationPending(desired, latest)
+}
+
+// authTokenChanged returns true if the secret referenced by the AUTH token
+// changed since the AUTH token was last passed to ElastiCache, or if the AUTH
+// token was unset with the DELETE update strategy.
+func authTokenChanged(desired *resource, latest *resource) bool {
+	if desired.ko.Spec.AuthToken == nil {
+		return authTokenUpdateStrategy(desired) == svcsdktypes.AuthTokenUpdateStrategyTypeDelete &&
+			latest.ko.Status.AuthTokenEnabled != nil && *latest.ko.Status.AuthTokenEnabled
+	}
+	observed, ok := latest.ko.ObjectMeta.GetAnnotations()[annotationObservedAuthToken]
+	if !ok {
+		return false
+	}
+	return observed != desired.ko.ObjectMeta.GetAnnotations()[AnnotationLastRequestedAuthToken]
+}
+
+// authTokenRotationPending returns true if the AUTH token stored in the
+// referenced secret was last passed to ElastiCache with the ROTATE strategy,
+// which still has to be finalized with SET.
+func authTokenRotationPending(desired *resource, latest *resource) bool {
+	if desired.ko.Spec.AuthToken == nil {
+		return false
+	}
+	annotations := desired.ko.ObjectMeta.GetAnnotations()
+	observed, ok := latest.ko.ObjectMeta.GetAnnotations()[annotationObservedAuthToken]
+	return ok && observed == annotations[AnnotationLastRequestedAuthToken] &&
+		annotations[AnnotationLastRequestedAuthTokenUpdateStrategy] == string(svcsdktypes.AuthTokenUpdateStrategyTypeRotate)
+}
+
+// authTokenUpdateStrategy returns the AUTH token update strategy requested in
+// the spec, which defaults to ROTATE.
+func authTokenUpdateStrategy(r *resource) svcsdktypes.AuthTokenUpdateStrategyType {
+	if r.ko.Spec.AuthTokenUpdateStrategy == nil {
+		return svcsdktypes.AuthTokenUpdateStrategyTypeRotate
+	}
+	return svcsdktypes.AuthTokenUpdateStrategyType(*r.ko.Spec.AuthTokenUpdateStrategy)
+}
+
+// updateAuthToken calls ModifyReplicationGroup to pass the AUTH token stored
+// in the referenced secret to ElastiCache. A ROTATE is finalized with SET once
+// util.AuthTokenRotationGracePeriod has passed since
+// Status.AuthTokenLastModifiedDate, which is the rotation checkpoint.
+func (rm *resourceManager) updateAuthToken(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (*resource, error) {
+	annotations := desired.ko.ObjectMeta.GetAnnotations()
+	lastRequested := annotations[AnnotationLastRequestedAuthToken]
+	observed := latest.ko.ObjectMeta.GetAnnotations()[annotationObservedAuthToken]
+	strategy := authTokenUpdateStrategy(desired)
+
+	if desired.ko.Spec.AuthToken != nil && lastRequested == "" {
+		// The replication group was created before AUTH tokens were tracked,
+		// the current token is taken as the one ElastiCache knows.
+		ko := desired.ko.DeepCopy()
+		getAnnotationsFields(desired, ko)[AnnotationLastRequestedAuthToken] = observed
+		return &resource{ko}, nil
+	}
+	if desired.ko.Spec.AuthToken != nil && observed == lastRequested {
+		// The AUTH token did not change since the last ROTATE, finalize it.
+		checkpoint := latest.ko.Status.AuthTokenLastModifiedDate
+		if checkpoint != nil {
+			if wait := time.Until(checkpoint.Add(util.AuthTokenRotationGracePeriod())); wait > 0 {
+				return nil, ackrequeue.NeededAfter(
+					errors.New("AUTH token rotation is waiting to be finalized"),
+					wait)
+			}
+		}
+		strategy = svcsdktypes.AuthTokenUpdateStrategyTypeSet
+	}
+
+	input := &svcsdk.ModifyReplicationGroupInput{
+		// Always applied immediately, as documented on Spec.ApplyImmediately.
+		ApplyImmediately:        aws.Bool(true),
+		ReplicationGroupId:      desired.ko.Spec.ReplicationGroupID,
+		AuthTokenUpdateStrategy: strategy,
+	}
+	if desired.ko.Spec.AuthToken != nil {
+		token, err := rm.rr.SecretValueFromReference(ctx, desired.ko.Spec.AuthToken)
+		if err != nil {
+			return nil, ackrequeue.Needed(err)
+		}
+		input.AuthToken = aws.String(token)
+	}
+	resp, err := rm.sdkapi.ModifyReplicationGroup(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyReplicationGroup", err)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := rm.setReplicationGroupOutput(ctx, desired, resp.ReplicationGroup)
+	if err != nil {
+		return nil, err
+	}
+	annotations = getAnnotationsFields(desired, updated.ko)
+	if desired.ko.Spec.AuthToken != nil {
+		annotations[AnnotationLastRequestedAuthToken] = observed
+	} else {
+		delete(annotations, AnnotationLastRequestedAuthToken)
+	}
+	annotations[AnnotationLastRequestedAuthTokenUpdateStrategy] = string(strategy)
+	return updated, nil
+}
+
+// desiredTransitEncryption returns the in-transit encryption state requested in
+// the spec, or an empty string if neither TransitEncryptionEnabled nor
+// TransitEncryptionMode is set.
+func desiredTransitEncryption(r *resource) string {
+	enabled := r.ko.Spec.TransitEncryptionEnabled
+	switch {
+	case enabled != nil && !*enabled:
+		return transitEncryptionDisabled
+	case r.ko.Spec.TransitEncryptionMode != nil:
+		return *r.ko.Spec.TransitEncryptionMode
+	case enabled != nil:
+		return transitEncryptionRequired
+	}
+	return ""
+}
+
+// observedTransitEncryption returns the in-transit encryption state of the
+// replication group. Replication groups which had in-transit encryption enabled
+// before TransitEncryptionMode existed only accept encrypted connections.
+func observedTransitEncryption(r *resource) string {
+	if r.ko.Spec.TransitEncryptionEnabled == nil || !*r.ko.Spec.TransitEncryptionEnabled {
+		return transitEncryptionDisabled
+	}
+	if r.ko.Spec.TransitEncryptionMode == nil {
+		return transitEncryptionRequired
+	}
+	return *r.ko.Spec.TransitEncryptionMode
+}
+
+// transitEncryptionRequiresUpdate returns true if the requested in-transit
+// encryption state differs from the one of the replication group.
+func transitEncryptionRequiresUpdate(desired *resource, latest *resource) bool {
+	target := desiredTransitEncryption(desired)
+	return target != "" && target != observedTransitEncryption(latest)
+}
+
+// setTransitEncryptionMigrationPhase clears the migration phase carried over
+// from the desired resource once the replication group reached the requested
+// in-transit encryption state.
+func setTransitEncryptionMigrationPhase(
+	desired *resource,
+	ko *svcapitypes.ReplicationGroup,
+) {
+	if !transitEncryptionRequiresUpdate(desired, &resource{ko}) {
+		ko.Status.TransitEncryptionMigrationPhase = nil
+	}
+}
+
+// migrateTransitEncryption calls ModifyReplicationGroup to move the replication
+// group one step closer to the requested in-transit encryption state:
+// disabled, preferred and required, in this order. Every call puts the
+// replication group in modifying state, so CustomModifyReplicationGroup only
+// applies the next step once it is available again. Steps are applied
+// immediately, whatever Spec.ApplyImmediately.
+func (rm *resourceManager) migrateTransitEncryption(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (*resource, error) {
+	input := &svcsdk.ModifyReplicationGroupInput{
+		ApplyImmediately:   aws.Bool(true),
+		ReplicationGroupId: desired.ko.Spec.ReplicationGroupID,
+	}
+	var phase string
+	current := observedTransitEncryption(latest)
+	switch target := desiredTransitEncryption(desired); {
+	case current == transitEncryptionDisabled:
+		phase = transitEncryptionPhaseEnablingPreferred
+		input.TransitEncryptionEnabled = aws.Bool(true)
+		input.TransitEncryptionMode = svcsdktypes.TransitEncryptionModePreferred
+	case current == transitEncryptionPreferred && target == transitEncryptionRequired:
+		phase = transitEncryptionPhaseEnforcingRequired
+		input.TransitEncryptionMode = svcsdktypes.TransitEncryptionModeRequired
+	case current == transitEncryptionRequired:
+		phase = transitEncryptionPhaseRelaxingToPreferred
+		input.TransitEncryptionMode = svcsdktypes.TransitEncryptionModePreferred
+	default:
+		phase = transitEncryptionPhaseDisabling
+		input.TransitEncryptionEnabled = aws.Bool(false)
+	}
+
+	resp, err := rm.sdkapi.ModifyReplicationGroup(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyReplicationGroup", err)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := rm.setReplicationGroupOutput(ctx, desired, resp.ReplicationGroup)
+	if err != nil {
+		return nil, err
+	}
+	// ModifyReplicationGroup returns the in-transit encryption state from
+	// before the step, keep the requested one until it is reached.
+	updated.ko.Spec.TransitEncryptionEnabled = desired.ko.Spec.TransitEncryptionEnabled
+	updated.ko.Status.TransitEncryptionMigrationPhase = &phase
+	return updated, nil
+}
+
+// observedClusterMode returns the cluster mode of the replication group,
+// falling back to Status.ClusterEnabled when ElastiCache does not report it.
+func observedClusterMode(r *resource) string {
+	if r.ko.Spec.ClusterMode != nil {
+		return *r.ko.Spec.ClusterMode
+	}
+	if r.ko.Status.ClusterEnabled != nil && *r.ko.Status.ClusterEnabled {
+		return string(svcsdktypes.ClusterModeEnabled)
+	}
+	return string(svcsdktypes.ClusterModeDisabled)
+}
+
+// clusterModeRequiresUpdate returns true if the requested cluster mode differs
+// from the one of the replication group.
+func clusterModeRequiresUpdate(desired *resource, latest *resource) bool {
+	return desired.ko.Spec.ClusterMode != nil && *desired.ko.Spec.ClusterMode != observedClusterMode(latest)
+}
+
+// clusterModeEnabledConfirmed returns true if the
+// AnnotationConfirmClusterModeEnabled annotation is set to true.
+func clusterModeEnabledConfirmed(r *resource) bool {
+	confirmed, err := strconv.ParseBool(r.ko.ObjectMeta.GetAnnotations()[AnnotationConfirmClusterModeEnabled])
+	return err == nil && confirmed
+}
+
+// clusterModeAwaitingConfirmation returns true if the next step towards the
+// requested cluster mode is to enable it, which waits until the user confirmed
+// with AnnotationConfirmClusterModeEnabled that all clients are cluster-aware.
+func clusterModeAwaitingConfirmation(desired *resource, latest *resource) bool {
+	return clusterModeRequiresUpdate(desired, latest) &&
+		*desired.ko.Spec.ClusterMode == string(svcsdktypes.ClusterModeEnabled) &&
+		observedClusterMode(latest) == string(svcsdktypes.ClusterModeCompatible) &&
+		!clusterModeEnabledConfirmed(desired)
+}
+
+// nonBlockingDifferences returns the differences that do not hold back the
+// changes applied once every other difference is resolved: the tags, which are
+// synced first, and a cluster mode waiting for its confirmation.
+func nonBlockingDifferences(desired *resource, latest *resource) []string {
+	paths := []string{"Spec.Tags"}
+	if clusterModeAwaitingConfirmation(desired, latest) {
+		paths = append(paths, "Spec.ClusterMode")
+	}
+	return paths
+}
+
+// migrateClusterMode calls ModifyReplicationGroup to move the replication group
+// one step closer to the requested cluster mode. A cluster mode disabled
+// replication group first goes through compatible, and only moves on to enabled
+// once clusterModeAwaitingConfirmation is false. ElastiCache does not allow to
+// leave the enabled cluster mode. Like in-transit encryption steps, cluster
+// mode steps ignore Spec.ApplyImmediately.
+func (rm *resourceManager) migrateClusterMode(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (*resource, error) {
+	current := svcsdktypes.ClusterMode(observedClusterMode(latest))
+	target := svcsdktypes.ClusterMode(*desired.ko.Spec.ClusterMode)
+
+	next := target
+	switch {
+	case current == svcsdktypes.ClusterModeEnabled:
+		return nil, ackerr.NewTerminalError(errors.New("Cannot update ClusterMode, " +
+			"cluster mode can not be disabled once it is enabled"))
+	case current == svcsdktypes.ClusterModeDisabled && target == svcsdktypes.ClusterModeEnabled:
+		next = svcsdktypes.ClusterModeCompatible
+	}
+
+	input := &svcsdk.ModifyReplicationGroupInput{
+		ApplyImmediately:   aws.Bool(true),
+		ReplicationGroupId: desired.ko.Spec.ReplicationGroupID,
+		ClusterMode:        next,
+	}
+	resp, err := rm.sdkapi.ModifyReplicationGroup(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyReplicationGroup", err)
+	if err != nil {
+		return nil, err
+	}
+	return rm.setReplicationGroupOutput(ctx, desired, resp.ReplicationGroup)
 }
 
 // logDeliveryRequiresUpdate retrieves the last requested configurations saved in annotations and compares them
@@ -1515,3 +2170,41 @@ func Int32OrNil(i *int64) *int32 {
 	}
 	return aws.Int32(int32(*i))
 }
+
+// describeFromCache returns a context in which the replication group is described from the
+// describe cache, if the replication group is available and the describe cache can serve it.
+// A replication group in any other state is described directly, so that its transitions are
+// observed without delay.
+func (rm *resourceManager) describeFromCache(
+	ctx context.Context,
+	r *resource,
+) context.Context {
+	if r.ko.Status.Status == nil || *r.ko.Status.Status != "available" {
+		return ctx
+	}
+	rg, ok, err := util.CachedReplicationGroup(
+		ctx, rm.sdkapi, rm.metrics, rm.awsAccountID, rm.awsRegion, r.ko.Spec.ReplicationGroupID,
+	)
+	if err != nil {
+		rm.log.V(1).Info("Error during DescribeReplicationGroups for the describe cache", "error", err)
+		return ctx
+	}
+	if !ok || rg.Status == nil || *rg.Status != "available" {
+		return ctx
+	}
+	return util.WithCachedOutput(ctx, "DescribeReplicationGroups", &svcsdk.DescribeReplicationGroupsOutput{
+		ReplicationGroups: []svcsdktypes.ReplicationGroup{*rg},
+	})
+}
+
+// invalidateDescribeCache stops the replication group from being served from the
+// describe cache until it is listed again.
+func (rm *resourceManager) invalidateDescribeCache(r *resource) {
+	util.InvalidateDescribeCache(rm.awsAccountID, rm.awsRegion, r.ko.Spec.ReplicationGroupID)
+}
+
+// requeueWhileThrottled returns a requeue error while ElastiCache requests of
+// the account and region are throttled, and nil otherwise.
+func (rm *resourceManager) requeueWhileThrottled() error {
+	return util.RequeueWhileThrottled(rm.awsAccountID, rm.awsRegion)
+}
@@ -58,6 +58,10 @@ func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) ack
 		ko.Spec.CacheSubnetGroupName = nil
 	}
 
+	if ko.Spec.GlobalReplicationGroupRef != nil {
+		ko.Spec.GlobalReplicationGroupID = nil
+	}
+
 	if ko.Spec.KMSKeyRef != nil {
 		ko.Spec.KMSKeyID = nil
 	}
@@ -70,6 +74,10 @@ func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) ack
 		ko.Spec.SecurityGroupIDs = nil
 	}
 
+	if ko.Spec.ServerlessCacheSnapshotRef != nil {
+		ko.Spec.ServerlessCacheSnapshotName = nil
+	}
+
 	if ko.Spec.SnapshotRef != nil {
 		ko.Spec.SnapshotName = nil
 	}
@@ -109,6 +117,12 @@ func (rm *resourceManager) ResolveReferences(
 		resourceHasReferences = resourceHasReferences || fieldHasReferences
 	}
 
+	if fieldHasReferences, err := rm.resolveReferenceForGlobalReplicationGroupID(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForKMSKeyID(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
@@ -127,6 +141,12 @@ func (rm *resourceManager) ResolveReferences(
 		resourceHasReferences = resourceHasReferences || fieldHasReferences
 	}
 
+	if fieldHasReferences, err := rm.resolveReferenceForServerlessCacheSnapshotName(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForSnapshotName(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
@@ -154,6 +174,10 @@ func validateReferenceFields(ko *svcapitypes.ReplicationGroup) error {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("CacheSubnetGroupName", "CacheSubnetGroupRef")
 	}
 
+	if ko.Spec.GlobalReplicationGroupRef != nil && ko.Spec.GlobalReplicationGroupID != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("GlobalReplicationGroupID", "GlobalReplicationGroupRef")
+	}
+
 	if ko.Spec.KMSKeyRef != nil && ko.Spec.KMSKeyID != nil {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("KMSKeyID", "KMSKeyRef")
 	}
@@ -166,6 +190,10 @@ func validateReferenceFields(ko *svcapitypes.ReplicationGroup) error {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("SecurityGroupIDs", "SecurityGroupRefs")
 	}
 
+	if ko.Spec.ServerlessCacheSnapshotRef != nil && ko.Spec.ServerlessCacheSnapshotName != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("ServerlessCacheSnapshotName", "ServerlessCacheSnapshotRef")
+	}
+
 	if ko.Spec.SnapshotRef != nil && ko.Spec.SnapshotName != nil {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("SnapshotName", "SnapshotRef")
 	}
@@ -358,6 +386,97 @@ func getReferencedResourceState_CacheSubnetGroup(
 	return nil
 }
 
+// resolveReferenceForGlobalReplicationGroupID reads the resource referenced
+// from GlobalReplicationGroupRef field and sets the GlobalReplicationGroupID
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForGlobalReplicationGroupID(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.ReplicationGroup,
+) (hasReferences bool, err error) {
+	if ko.Spec.GlobalReplicationGroupRef != nil && ko.Spec.GlobalReplicationGroupRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.GlobalReplicationGroupRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: GlobalReplicationGroupRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.GlobalReplicationGroup{}
+		if err := getReferencedResourceState_GlobalReplicationGroup(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.GlobalReplicationGroupID = (*string)(obj.Status.GlobalReplicationGroupID)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_GlobalReplicationGroup looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_GlobalReplicationGroup(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.GlobalReplicationGroup,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"GlobalReplicationGroup",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"GlobalReplicationGroup",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"GlobalReplicationGroup",
+			namespace, name)
+	}
+	if obj.Status.GlobalReplicationGroupID == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"GlobalReplicationGroup",
+			namespace, name,
+			"Status.GlobalReplicationGroupID")
+	}
+	return nil
+}
+
 // resolveReferenceForKMSKeyID reads the resource referenced
 // from KMSKeyRef field and sets the KMSKeyID
 // from referenced resource. Returns a boolean indicating whether a reference
@@ -636,6 +755,97 @@ func getReferencedResourceState_SecurityGroup(
 	return nil
 }
 
+// resolveReferenceForServerlessCacheSnapshotName reads the resource referenced
+// from ServerlessCacheSnapshotRef field and sets the ServerlessCacheSnapshotName
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForServerlessCacheSnapshotName(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.ReplicationGroup,
+) (hasReferences bool, err error) {
+	if ko.Spec.ServerlessCacheSnapshotRef != nil && ko.Spec.ServerlessCacheSnapshotRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.ServerlessCacheSnapshotRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: ServerlessCacheSnapshotRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.ServerlessCacheSnapshot{}
+		if err := getReferencedResourceState_ServerlessCacheSnapshot(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.ServerlessCacheSnapshotName = (*string)(obj.Spec.ServerlessCacheSnapshotName)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_ServerlessCacheSnapshot looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_ServerlessCacheSnapshot(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.ServerlessCacheSnapshot,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"ServerlessCacheSnapshot",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"ServerlessCacheSnapshot",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"ServerlessCacheSnapshot",
+			namespace, name)
+	}
+	if obj.Spec.ServerlessCacheSnapshotName == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"ServerlessCacheSnapshot",
+			namespace, name,
+			"Spec.ServerlessCacheSnapshotName")
+	}
+	return nil
+}
+
 // resolveReferenceForSnapshotName reads the resource referenced
 // from SnapshotRef field and sets the SnapshotName
 // from referenced resource. Returns a boolean indicating whether a reference
@@ -37,6 +37,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/elasticache-controller/pkg/util"
 )
 
 // Hack to avoid import errors during build...
@@ -71,6 +72,14 @@ func (rm *resourceManager) sdkFind(
 		return nil, ackerr.NotFound
 	}
 
+	if err := rm.requeueWhileThrottled(); err != nil {
+		return nil, err
+	}
+	if err := rm.adoptFinalSnapshot(ctx, r); err != nil {
+		return nil, err
+	}
+	ctx = rm.describeFromCache(ctx, r)
+
 	input, err := rm.newListRequestPayload(r)
 	if err != nil {
 		return nil, err
@@ -134,6 +143,11 @@ func (rm *resourceManager) sdkFind(
 		} else {
 			ko.Status.ClusterEnabled = nil
 		}
+		if elem.ClusterMode != "" {
+			ko.Spec.ClusterMode = aws.String(string(elem.ClusterMode))
+		} else {
+			ko.Spec.ClusterMode = nil
+		}
 		if elem.ConfigurationEndpoint != nil {
 			f8 := &svcapitypes.Endpoint{}
 			if elem.ConfigurationEndpoint.Address != nil {
@@ -438,6 +452,11 @@ func (rm *resourceManager) sdkFind(
 		} else {
 			ko.Spec.TransitEncryptionEnabled = nil
 		}
+		if elem.TransitEncryptionMode != "" {
+			ko.Spec.TransitEncryptionMode = aws.String(string(elem.TransitEncryptionMode))
+		} else {
+			ko.Spec.TransitEncryptionMode = nil
+		}
 		if elem.UserGroupIds != nil {
 			ko.Spec.UserGroupIDs = aws.StringSlice(elem.UserGroupIds)
 		} else {
@@ -505,6 +524,22 @@ func (rm *resourceManager) sdkFind(
 		return &resource{ko}, nil
 	}
 
+	joining, err := rm.joiningGlobalReplicationGroup(ctx, &resource{ko})
+	if err != nil {
+		return nil, err
+	}
+	if joining {
+		// Membership is reported in Status.GlobalReplicationGroupInfo once the
+		// Global datastore has associated this replication group. Keep the
+		// resource out of sync so it is requeued until then.
+		ackcondition.SetSynced(
+			&resource{ko},
+			corev1.ConditionFalse,
+			&condMsgJoiningGlobalReplicationGroup,
+			nil,
+		)
+	}
+
 	if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN != nil {
 		resourceARN := (*string)(ko.Status.ACKResourceMetadata.ARN)
 		tags, err := rm.getTags(ctx, *resourceARN)
@@ -960,6 +995,9 @@ func (rm *resourceManager) newCreateRequestPayload(
 	if r.ko.Spec.CacheSubnetGroupName != nil {
 		res.CacheSubnetGroupName = r.ko.Spec.CacheSubnetGroupName
 	}
+	if r.ko.Spec.ClusterMode != nil {
+		res.ClusterMode = svcsdktypes.ClusterMode(*r.ko.Spec.ClusterMode)
+	}
 	if r.ko.Spec.DataTieringEnabled != nil {
 		res.DataTieringEnabled = r.ko.Spec.DataTieringEnabled
 	}
@@ -972,6 +1010,9 @@ func (rm *resourceManager) newCreateRequestPayload(
 	if r.ko.Spec.EngineVersion != nil {
 		res.EngineVersion = r.ko.Spec.EngineVersion
 	}
+	if r.ko.Spec.GlobalReplicationGroupID != nil {
+		res.GlobalReplicationGroupId = r.ko.Spec.GlobalReplicationGroupID
+	}
 	if r.ko.Spec.IPDiscovery != nil {
 		res.IpDiscovery = svcsdktypes.IpDiscovery(*r.ko.Spec.IPDiscovery)
 	}
@@ -1101,6 +1142,9 @@ func (rm *resourceManager) newCreateRequestPayload(
 	if r.ko.Spec.SecurityGroupIDs != nil {
 		res.SecurityGroupIds = aws.ToStringSlice(r.ko.Spec.SecurityGroupIDs)
 	}
+	if r.ko.Spec.ServerlessCacheSnapshotName != nil {
+		res.ServerlessCacheSnapshotName = r.ko.Spec.ServerlessCacheSnapshotName
+	}
 	if r.ko.Spec.SnapshotARNs != nil {
 		res.SnapshotArns = aws.ToStringSlice(r.ko.Spec.SnapshotARNs)
 	}
@@ -1135,6 +1179,9 @@ func (rm *resourceManager) newCreateRequestPayload(
 	if r.ko.Spec.TransitEncryptionEnabled != nil {
 		res.TransitEncryptionEnabled = r.ko.Spec.TransitEncryptionEnabled
 	}
+	if r.ko.Spec.TransitEncryptionMode != nil {
+		res.TransitEncryptionMode = svcsdktypes.TransitEncryptionMode(*r.ko.Spec.TransitEncryptionMode)
+	}
 	if r.ko.Spec.UserGroupIDs != nil {
 		res.UserGroupIds = aws.ToStringSlice(r.ko.Spec.UserGroupIDs)
 	}
@@ -1155,11 +1202,32 @@ func (rm *resourceManager) sdkUpdate(
 	defer func() {
 		exit(err)
 	}()
+	defer rm.invalidateDescribeCache(desired)
+
+	done, err := util.SyncConnectionSecret(
+		ctx, delta, desired.ko, desired.ko.Spec.ConnectionSecret,
+		func() util.ConnectionDetails { return connectionDetails(desired, latest) },
+		rm.rr.SecretValueFromReference,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if done {
+		// The connection Secret was the only difference.
+		return desired, nil
+	}
 	if delta.DifferentAt("Spec.Tags") {
 		if err = rm.syncTags(ctx, desired, latest); err != nil {
 			return nil, err
 		}
 	}
+	if delta.DifferentAt("Spec.ServiceUpdateName") && !delta.DifferentExcept(append(nonBlockingDifferences(desired, latest), "Spec.ServiceUpdateName")...) {
+		// Service updates are applied once every other difference is resolved.
+		if err = rm.syncServiceUpdate(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		return desired, nil
+	}
 
 	updated, err = rm.CustomModifyReplicationGroup(ctx, desired, latest, delta)
 	if updated != nil || err != nil {
@@ -1169,12 +1237,21 @@ func (rm *resourceManager) sdkUpdate(
 	if err != nil {
 		return nil, err
 	}
+	input.ApplyImmediately = applyImmediately(desired)
 	if !delta.DifferentAt("Spec.LogDeliveryConfigurations") {
 		input.LogDeliveryConfigurations = nil
 	}
-	if !delta.DifferentAt("Spec.TransitEncryptionEnabled") {
-		input.TransitEncryptionEnabled = nil
-	}
+	// AUTH token changes are passed to ElastiCache by updateAuthToken, along
+	// with their update strategy.
+	input.AuthToken = nil
+	input.AuthTokenUpdateStrategy = ""
+	// In-transit encryption changes are applied one step at a time by
+	// migrateTransitEncryption.
+	input.TransitEncryptionEnabled = nil
+	input.TransitEncryptionMode = ""
+	// Cluster mode changes are applied one step at a time by
+	// migrateClusterMode.
+	input.ClusterMode = ""
 	if !delta.DifferentAt("Spec.Durability") {
 		input.Durability = ""
 	}
@@ -1617,7 +1694,9 @@ func (rm *resourceManager) newUpdateRequestPayload(
 ) (*svcsdk.ModifyReplicationGroupInput, error) {
 	res := &svcsdk.ModifyReplicationGroupInput{}
 
-	res.ApplyImmediately = aws.Bool(true)
+	if r.ko.Spec.ApplyImmediately != nil {
+		res.ApplyImmediately = r.ko.Spec.ApplyImmediately
+	}
 	if r.ko.Spec.AuthToken != nil {
 		tmpSecret, err := rm.rr.SecretValueFromReference(ctx, r.ko.Spec.AuthToken)
 		if err != nil {
@@ -1627,6 +1706,9 @@ func (rm *resourceManager) newUpdateRequestPayload(
 			res.AuthToken = aws.String(tmpSecret)
 		}
 	}
+	if r.ko.Spec.AuthTokenUpdateStrategy != nil {
+		res.AuthTokenUpdateStrategy = svcsdktypes.AuthTokenUpdateStrategyType(*r.ko.Spec.AuthTokenUpdateStrategy)
+	}
 	if r.ko.Status.AutoMinorVersionUpgrade != nil {
 		res.AutoMinorVersionUpgrade = r.ko.Status.AutoMinorVersionUpgrade
 	}
@@ -1642,6 +1724,9 @@ func (rm *resourceManager) newUpdateRequestPayload(
 	if r.ko.Spec.CacheSecurityGroupNames != nil {
 		res.CacheSecurityGroupNames = aws.ToStringSlice(r.ko.Spec.CacheSecurityGroupNames)
 	}
+	if r.ko.Spec.ClusterMode != nil {
+		res.ClusterMode = svcsdktypes.ClusterMode(*r.ko.Spec.ClusterMode)
+	}
 	if r.ko.Spec.Durability != nil {
 		res.Durability = svcsdktypes.Durability(*r.ko.Spec.Durability)
 	}
@@ -1724,6 +1809,9 @@ func (rm *resourceManager) newUpdateRequestPayload(
 	if r.ko.Spec.TransitEncryptionEnabled != nil {
 		res.TransitEncryptionEnabled = r.ko.Spec.TransitEncryptionEnabled
 	}
+	if r.ko.Spec.TransitEncryptionMode != nil {
+		res.TransitEncryptionMode = svcsdktypes.TransitEncryptionMode(*r.ko.Spec.TransitEncryptionMode)
+	}
 
 	return res, nil
 }
@@ -1752,7 +1840,7 @@ func (rm *resourceManager) sdkDelete(
 		// - reconciler.HandleReconcileError() does not update status for unmanaged resource
 		// - reconciler.handleRequeues() is not invoked for delete code path.
 		// TODO: return err as nil when reconciler is updated.
-		return r, requeueWaitWhileDeleting
+		return r, rm.requeueWaitWhileDeleting(r)
 	}
 	if isModifying(r) {
 		// Setting resource synced condition to false will trigger a requeue of
@@ -1768,13 +1856,18 @@ func (rm *resourceManager) sdkDelete(
 		// - reconciler.HandleReconcileError() does not update status for unmanaged resource
 		// - reconciler.handleRequeues() is not invoked for delete code path.
 		// TODO: return err as nil when reconciler is updated.
-		return r, requeueWaitWhileModifying
+		return r, rm.requeueWaitWhileModifying(r)
+	}
+	if err := util.RequeueIfDeletionProtected(r); err != nil {
+		return r, err
 	}
 
 	input, err := rm.newDeleteRequestPayload(r)
 	if err != nil {
 		return nil, err
 	}
+	input.FinalSnapshotIdentifier = finalSnapshotIdentifier(r)
+
 	var resp *svcsdk.DeleteReplicationGroupOutput
 	_ = resp
 	resp, err = rm.sdkapi.DeleteReplicationGroup(ctx, input)
@@ -1795,7 +1888,7 @@ func (rm *resourceManager) sdkDelete(
 		// - reconciler.HandleReconcileError() does not update status for unmanaged resource
 		// - reconciler.handleRequeues() is not invoked for delete code path.
 		// TODO: return err as nil when reconciler is updated.
-		return rp, requeueWaitWhileDeleting
+		return rp, rm.requeueWaitWhileDeleting(r)
 	}
 
 	return nil, err
@@ -1808,9 +1901,15 @@ func (rm *resourceManager) newDeleteRequestPayload(
 ) (*svcsdk.DeleteReplicationGroupInput, error) {
 	res := &svcsdk.DeleteReplicationGroupInput{}
 
+	if r.ko.Spec.FinalSnapshotIdentifier != nil {
+		res.FinalSnapshotIdentifier = r.ko.Spec.FinalSnapshotIdentifier
+	}
 	if r.ko.Spec.ReplicationGroupID != nil {
 		res.ReplicationGroupId = r.ko.Spec.ReplicationGroupID
 	}
+	if r.ko.Spec.RetainPrimaryCluster != nil {
+		res.RetainPrimaryCluster = r.ko.Spec.RetainPrimaryCluster
+	}
 
 	return res, nil
 }
@@ -0,0 +1,178 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package reserved_cache_node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/elasticache-controller/pkg/util"
+)
+
+var errOfferingFiltersMissing = ackerr.NewTerminalError(errors.New(
+	"either reservedCacheNodesOfferingID or cacheNodeType, duration and offeringType must be set",
+))
+
+// customUpdateReservedCacheNode handles updates for reserved cache nodes.
+// Every other field is immutable, so only tags can be updated.
+func (rm *resourceManager) customUpdateReservedCacheNode(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdateReservedCacheNode")
+	defer func() { exit(err) }()
+
+	ko := desired.ko.DeepCopy()
+	latest.ko.Status.DeepCopyInto(&ko.Status)
+	rm.setStatusDefaults(ko)
+
+	if delta.DifferentAt("Spec.Tags") {
+		if err := rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	return &resource{ko}, nil
+}
+
+// customDeleteReservedCacheNode stops managing the reservation. Reserved cache
+// nodes cannot be cancelled, so the reservation is left untouched and keeps
+// running until the end of its term.
+func (rm *resourceManager) customDeleteReservedCacheNode(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customDeleteReservedCacheNode")
+	defer func() { exit(err) }()
+
+	rlog.Info(
+		"reserved cache nodes cannot be cancelled, leaving reservation in place",
+		"reservedCacheNodeID", aws.ToString(r.ko.Spec.ReservedCacheNodeID),
+	)
+	return nil, nil
+}
+
+// findReservedCacheNodesOfferingID looks up the ID of the reserved cache node
+// offering matching the cache node type, duration, offering type and
+// (optional) product description of the supplied resource. A terminal error is
+// returned unless exactly one offering matches.
+func (rm *resourceManager) findReservedCacheNodesOfferingID(
+	ctx context.Context,
+	r *resource,
+) (*string, error) {
+	spec := r.ko.Spec
+	if spec.CacheNodeType == nil || spec.Duration == nil || spec.OfferingType == nil {
+		return nil, errOfferingFiltersMissing
+	}
+
+	offerings := []svcsdktypes.ReservedCacheNodesOffering{}
+	var paginationMarker *string
+	for {
+		input := &svcsdk.DescribeReservedCacheNodesOfferingsInput{
+			CacheNodeType:      spec.CacheNodeType,
+			Duration:           spec.Duration,
+			OfferingType:       spec.OfferingType,
+			ProductDescription: spec.ProductDescription,
+			Marker:             paginationMarker,
+		}
+		resp, err := rm.sdkapi.DescribeReservedCacheNodesOfferings(ctx, input)
+		rm.metrics.RecordAPICall("READ_MANY", "DescribeReservedCacheNodesOfferings", err)
+		if err != nil {
+			return nil, err
+		}
+		offerings = append(offerings, resp.ReservedCacheNodesOfferings...)
+		paginationMarker = resp.Marker
+		if paginationMarker == nil || *paginationMarker == "" {
+			break
+		}
+	}
+
+	switch len(offerings) {
+	case 0:
+		return nil, ackerr.NewTerminalError(fmt.Errorf(
+			"no reserved cache node offering found for cacheNodeType %s, duration %s, offeringType %s and productDescription %s",
+			*spec.CacheNodeType, *spec.Duration, *spec.OfferingType, aws.ToString(spec.ProductDescription),
+		))
+	case 1:
+		return offerings[0].ReservedCacheNodesOfferingId, nil
+	}
+	matches := make([]string, 0, len(offerings))
+	for _, o := range offerings {
+		matches = append(matches, fmt.Sprintf("%s (%s)",
+			aws.ToString(o.ReservedCacheNodesOfferingId), aws.ToString(o.ProductDescription)))
+	}
+	return nil, ackerr.NewTerminalError(fmt.Errorf(
+		"%d reserved cache node offerings match, set productDescription or reservedCacheNodesOfferingID to select one of: %s",
+		len(offerings), strings.Join(matches, ", "),
+	))
+}
+
+// setReservationARN stores the ARN of the reservation in the resource
+// metadata, as it is not named after the resource.
+func setReservationARN(
+	ko *svcapitypes.ReservedCacheNode,
+	reservationARN *string,
+) {
+	if reservationARN == nil {
+		return
+	}
+	if ko.Status.ACKResourceMetadata == nil {
+		ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+	}
+	arn := ackv1alpha1.AWSResourceName(*reservationARN)
+	ko.Status.ACKResourceMetadata.ARN = &arn
+}
+
+// getTags retrieves the tags for a given ReservedCacheNode
+func (rm *resourceManager) getTags(
+	ctx context.Context,
+	resourceARN string,
+) ([]*svcapitypes.Tag, error) {
+	return util.GetTags(ctx, rm.sdkapi, rm.metrics, resourceARN)
+}
+
+// syncTags synchronizes the tags between the resource spec and the AWS resource
+func (rm *resourceManager) syncTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) error {
+	if latest.ko.Status.ACKResourceMetadata == nil || latest.ko.Status.ACKResourceMetadata.ARN == nil {
+		return nil
+	}
+
+	return util.SyncTags(
+		ctx,
+		desired.ko.Spec.Tags,
+		latest.ko.Spec.Tags,
+		latest.ko.Status.ACKResourceMetadata,
+		convertToOrderedACKTags,
+		rm.sdkapi,
+		rm.metrics,
+	)
+}
@@ -127,6 +127,16 @@ func newResourceDelta(
 			delta.Add("Spec.MajorEngineVersion", a.ko.Spec.MajorEngineVersion, b.ko.Spec.MajorEngineVersion)
 		}
 	}
+	if len(a.ko.Spec.NodeSnapshotARNsToRestore) != len(b.ko.Spec.NodeSnapshotARNsToRestore) {
+		delta.Add("Spec.NodeSnapshotARNsToRestore", a.ko.Spec.NodeSnapshotARNsToRestore, b.ko.Spec.NodeSnapshotARNsToRestore)
+	} else if len(a.ko.Spec.NodeSnapshotARNsToRestore) > 0 {
+		if !ackcompare.SliceStringPEqual(a.ko.Spec.NodeSnapshotARNsToRestore, b.ko.Spec.NodeSnapshotARNsToRestore) {
+			delta.Add("Spec.NodeSnapshotARNsToRestore", a.ko.Spec.NodeSnapshotARNsToRestore, b.ko.Spec.NodeSnapshotARNsToRestore)
+		}
+	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.NodeSnapshotARNsToRestoreRefs, b.ko.Spec.NodeSnapshotARNsToRestoreRefs) {
+		delta.Add("Spec.NodeSnapshotARNsToRestoreRefs", a.ko.Spec.NodeSnapshotARNsToRestoreRefs, b.ko.Spec.NodeSnapshotARNsToRestoreRefs)
+	}
 	if len(a.ko.Spec.SecurityGroupIDs) != len(b.ko.Spec.SecurityGroupIDs) {
 		delta.Add("Spec.SecurityGroupIDs", a.ko.Spec.SecurityGroupIDs, b.ko.Spec.SecurityGroupIDs)
 	} else if len(a.ko.Spec.SecurityGroupIDs) > 0 {
@@ -151,6 +161,9 @@ func newResourceDelta(
 			delta.Add("Spec.SnapshotARNsToRestore", a.ko.Spec.SnapshotARNsToRestore, b.ko.Spec.SnapshotARNsToRestore)
 		}
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.SnapshotARNsToRestoreRefs, b.ko.Spec.SnapshotARNsToRestoreRefs) {
+		delta.Add("Spec.SnapshotARNsToRestoreRefs", a.ko.Spec.SnapshotARNsToRestoreRefs, b.ko.Spec.SnapshotARNsToRestoreRefs)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.SnapshotRetentionLimit, b.ko.Spec.SnapshotRetentionLimit) {
 		delta.Add("Spec.SnapshotRetentionLimit", a.ko.Spec.SnapshotRetentionLimit, b.ko.Spec.SnapshotRetentionLimit)
 	} else if a.ko.Spec.SnapshotRetentionLimit != nil && b.ko.Spec.SnapshotRetentionLimit != nil {
@@ -184,5 +197,6 @@ func newResourceDelta(
 		delta.Add("Spec.UserGroupRef", a.ko.Spec.UserGroupRef, b.ko.Spec.UserGroupRef)
 	}
 
+	modifyDelta(delta, a, b)
 	return delta
 }
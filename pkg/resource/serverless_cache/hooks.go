@@ -15,11 +15,12 @@ package serverless_cache
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"time"
 
 	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
 	"github.com/aws-controllers-k8s/elasticache-controller/pkg/util"
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
 	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
 	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
@@ -28,14 +29,21 @@ import (
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/elasticache"
 	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 const (
 	ServerlessCacheStatusCreating  = "creating"
 	ServerlessCacheStatusDeleting  = "deleting"
 	ServerlessCacheStatusModifying = "modifying"
+	ServerlessCacheStatusAvailable = "available"
 )
 
+// snapshotStatusAvailable is the status of Snapshot and ServerlessCacheSnapshot
+// resources which can be restored.
+const snapshotStatusAvailable = "available"
+
 var (
 	ErrServerlessCacheDeleting = fmt.Errorf(
 		"serverless cache in '%v' state, cannot be modified or deleted",
@@ -51,20 +59,20 @@ var (
 	)
 )
 
-var (
-	requeueWaitWhileDeleting = ackrequeue.NeededAfter(
-		ErrServerlessCacheDeleting,
-		5*time.Second,
-	)
-	requeueWaitWhileCreating = ackrequeue.NeededAfter(
-		ErrServerlessCacheCreating,
-		5*time.Second,
-	)
-	requeueWaitWhileModifying = ackrequeue.NeededAfter(
-		ErrServerlessCacheModifying,
-		10*time.Second,
-	)
-)
+func (rm *resourceManager) requeueWaitWhileDeleting(r *resource) error {
+	return util.RequeueWaitWhile(ErrServerlessCacheDeleting,
+		"ServerlessCache", r.ko, util.TransitionDeleting, rm.awsAccountID, rm.awsRegion)
+}
+
+func (rm *resourceManager) requeueWaitWhileCreating(r *resource) error {
+	return util.RequeueWaitWhile(ErrServerlessCacheCreating,
+		"ServerlessCache", r.ko, util.TransitionCreating, rm.awsAccountID, rm.awsRegion)
+}
+
+func (rm *resourceManager) requeueWaitWhileModifying(r *resource) error {
+	return util.RequeueWaitWhile(ErrServerlessCacheModifying,
+		"ServerlessCache", r.ko, util.TransitionModifying, rm.awsAccountID, rm.awsRegion)
+}
 
 // modifyServerlessCache is a central function that creates the input object and makes the API call
 // with consistent metrics recording
@@ -107,7 +115,17 @@ func isServerlessCacheModifying(r *resource) bool {
 	return *r.ko.Status.Status == ServerlessCacheStatusModifying
 }
 
-// customUpdateServerlessCache handles updates in a phased approach similar to DynamoDB table updates
+// endTransitionIfAvailable forgets the transition of the serverless cache
+// once it is available.
+func endTransitionIfAvailable(r *resource) {
+	if r.ko.Status.Status != nil && *r.ko.Status.Status == ServerlessCacheStatusAvailable {
+		util.EndTransition("ServerlessCache", r.ko)
+	}
+}
+
+// customUpdateServerlessCache applies the tags and every change planned by
+// planModification, then waits for the serverless cache to leave the modifying
+// state before the remaining changes, if any, are applied.
 func (rm *resourceManager) customUpdateServerlessCache(
 	ctx context.Context,
 	desired *resource,
@@ -117,21 +135,34 @@ func (rm *resourceManager) customUpdateServerlessCache(
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.customUpdateServerlessCache")
 	defer func() { exit(err) }()
+	defer rm.invalidateDescribeCache(desired)
 
 	if isServerlessCacheDeleting(latest) {
 		msg := "serverless cache is currently being deleted"
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
-		return desired, requeueWaitWhileDeleting
+		return desired, rm.requeueWaitWhileDeleting(latest)
 	}
 	if isServerlessCacheCreating(latest) {
 		msg := "serverless cache is currently being created"
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
-		return desired, requeueWaitWhileCreating
+		return desired, rm.requeueWaitWhileCreating(latest)
 	}
 	if isServerlessCacheModifying(latest) {
 		msg := "serverless cache is currently being modified"
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
-		return desired, requeueWaitWhileModifying
+		return desired, rm.requeueWaitWhileModifying(latest)
+	}
+	done, err := util.SyncConnectionSecret(
+		ctx, delta, desired.ko, desired.ko.Spec.ConnectionSecret,
+		func() util.ConnectionDetails { return connectionDetails(desired, latest) },
+		rm.rr.SecretValueFromReference,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if done {
+		// The connection Secret was the only difference.
+		return desired, nil
 	}
 
 	// Merge in the information we read from the API call above to the copy of
@@ -139,160 +170,157 @@ func (rm *resourceManager) customUpdateServerlessCache(
 	ko := desired.ko.DeepCopy()
 	rm.setStatusDefaults(ko)
 
-	switch {
-	case delta.DifferentAt("Spec.Description"):
-		if err := rm.syncDescription(ctx, desired); err != nil {
-			return nil, fmt.Errorf("cannot update serverless cache: %v", err)
-		}
-
-	case delta.DifferentAt("Spec.Tags"):
+	if delta.DifferentAt("Spec.Tags") {
 		if err := rm.syncTags(ctx, desired, latest); err != nil {
 			return &resource{ko}, err
 		}
+	}
 
-	case delta.DifferentAt("Spec.DailySnapshotTime"):
-		if err := rm.syncDailySnapshotTime(ctx, desired); err != nil {
-			return nil, fmt.Errorf("cannot update serverless cache: %v", err)
-		}
-
-	case delta.DifferentAt("Spec.SnapshotRetentionLimit"):
-		if err := rm.syncSnapshotRetentionLimit(ctx, desired); err != nil {
+	if configFunc := planModification(desired, delta); configFunc != nil {
+		if err := rm.modifyServerlessCache(ctx, desired.ko.Spec.ServerlessCacheName, configFunc); err != nil {
 			return nil, fmt.Errorf("cannot update serverless cache: %v", err)
 		}
+	}
 
-	case delta.DifferentAt("Spec.SecurityGroupIDs"):
-		if err := rm.syncSecurityGroupIDs(ctx, desired); err != nil {
-			return nil, fmt.Errorf("cannot update serverless cache: %v", err)
-		}
+	return &resource{ko}, rm.requeueWaitWhileModifying(latest)
+}
 
-	case delta.DifferentAt("Spec.UserGroupID"):
-		if err := rm.syncUserGroupID(ctx, desired); err != nil {
-			return nil, fmt.Errorf("cannot update serverless cache: %v", err)
-		}
+// batchableModifications lists the spec fields which ModifyServerlessCache
+// accepts together in a single call, along with the function setting each of
+// them on the input.
+var batchableModifications = []struct {
+	path  string
+	apply func(*svcsdk.ModifyServerlessCacheInput, *resource)
+}{
+	{"Spec.Description", setDescription},
+	{"Spec.DailySnapshotTime", setDailySnapshotTime},
+	{"Spec.SnapshotRetentionLimit", setSnapshotRetentionLimit},
+	{"Spec.SecurityGroupIDs", setSecurityGroupIDs},
+	{"Spec.UserGroupID", setUserGroupID},
+	{"Spec.CacheUsageLimits", setCacheUsageLimits},
+}
 
-	case delta.DifferentAt("Spec.CacheUsageLimits"):
-		if err := rm.syncCacheUsageLimits(ctx, desired); err != nil {
-			return nil, fmt.Errorf("cannot update serverless cache: %v", err)
+// planModification returns a function merging every batchable change found in
+// the delta into a single ModifyServerlessCacheInput. Engine and major engine
+// version upgrades can not be combined with other changes, they are planned on
+// their own once no other change is left. It returns nil if there is nothing
+// to modify.
+func planModification(
+	desired *resource,
+	delta *ackcompare.Delta,
+) func(*svcsdk.ModifyServerlessCacheInput) {
+	var applies []func(*svcsdk.ModifyServerlessCacheInput, *resource)
+	for _, m := range batchableModifications {
+		if delta.DifferentAt(m.path) {
+			applies = append(applies, m.apply)
 		}
-
-	case delta.DifferentAt("Spec.Engine") || delta.DifferentAt("Spec.MajorEngineVersion"):
-		if err := rm.syncEngineAndVersion(ctx, desired); err != nil {
-			return nil, fmt.Errorf("cannot update serverless cache: %v", err)
+	}
+	if len(applies) == 0 && (delta.DifferentAt("Spec.Engine") || delta.DifferentAt("Spec.MajorEngineVersion")) {
+		applies = append(applies, setEngineAndVersion)
+	}
+	if len(applies) == 0 {
+		return nil
+	}
+	return func(input *svcsdk.ModifyServerlessCacheInput) {
+		for _, apply := range applies {
+			apply(input, desired)
 		}
 	}
-
-	return &resource{ko}, requeueWaitWhileModifying
 }
 
-// syncDescription handles updating only the description field
-func (rm *resourceManager) syncDescription(
-	ctx context.Context,
+// setDescription sets the description field
+func setDescription(
+	input *svcsdk.ModifyServerlessCacheInput,
 	desired *resource,
-) error {
-	return rm.modifyServerlessCache(ctx, desired.ko.Spec.ServerlessCacheName, func(input *svcsdk.ModifyServerlessCacheInput) {
-		input.Description = desired.ko.Spec.Description
-	})
+) {
+	input.Description = desired.ko.Spec.Description
 }
 
-// syncDailySnapshotTime handles updating only the daily snapshot time field
-func (rm *resourceManager) syncDailySnapshotTime(
-	ctx context.Context,
+// setDailySnapshotTime sets the daily snapshot time field
+func setDailySnapshotTime(
+	input *svcsdk.ModifyServerlessCacheInput,
 	desired *resource,
-) error {
-	return rm.modifyServerlessCache(ctx, desired.ko.Spec.ServerlessCacheName, func(input *svcsdk.ModifyServerlessCacheInput) {
-		input.DailySnapshotTime = desired.ko.Spec.DailySnapshotTime
-	})
+) {
+	input.DailySnapshotTime = desired.ko.Spec.DailySnapshotTime
 }
 
-// syncSnapshotRetentionLimit handles updating only the snapshot retention limit field
-func (rm *resourceManager) syncSnapshotRetentionLimit(
-	ctx context.Context,
+// setSnapshotRetentionLimit sets the snapshot retention limit field
+func setSnapshotRetentionLimit(
+	input *svcsdk.ModifyServerlessCacheInput,
 	desired *resource,
-) error {
-	return rm.modifyServerlessCache(ctx, desired.ko.Spec.ServerlessCacheName, func(input *svcsdk.ModifyServerlessCacheInput) {
-		if desired.ko.Spec.SnapshotRetentionLimit != nil {
-			snapshotRetentionLimitCopy := int32(*desired.ko.Spec.SnapshotRetentionLimit)
-			input.SnapshotRetentionLimit = &snapshotRetentionLimitCopy
-		}
-	})
+) {
+	if desired.ko.Spec.SnapshotRetentionLimit != nil {
+		snapshotRetentionLimitCopy := int32(*desired.ko.Spec.SnapshotRetentionLimit)
+		input.SnapshotRetentionLimit = &snapshotRetentionLimitCopy
+	}
 }
 
-// syncSecurityGroupIDs handles updating only the security group IDs field
-func (rm *resourceManager) syncSecurityGroupIDs(
-	ctx context.Context,
+// setSecurityGroupIDs sets the security group IDs field
+func setSecurityGroupIDs(
+	input *svcsdk.ModifyServerlessCacheInput,
 	desired *resource,
-) error {
-	securityGroupIDs := aws.ToStringSlice(desired.ko.Spec.SecurityGroupIDs)
+) {
 	// AWS ElastiCache ModifyServerlessCache doesn't support unsetting SecurityGroupIds
-	err := rm.modifyServerlessCache(ctx, desired.ko.Spec.ServerlessCacheName, func(input *svcsdk.ModifyServerlessCacheInput) {
-		input.SecurityGroupIds = securityGroupIDs
-	})
-	return err
+	input.SecurityGroupIds = aws.ToStringSlice(desired.ko.Spec.SecurityGroupIDs)
 }
 
-// syncUserGroupID handles updating only the user group ID field
-func (rm *resourceManager) syncUserGroupID(
-	ctx context.Context,
+// setUserGroupID sets the user group ID field
+func setUserGroupID(
+	input *svcsdk.ModifyServerlessCacheInput,
 	desired *resource,
-) error {
-	return rm.modifyServerlessCache(ctx, desired.ko.Spec.ServerlessCacheName, func(input *svcsdk.ModifyServerlessCacheInput) {
-		input.UserGroupId = desired.ko.Spec.UserGroupID
-	})
+) {
+	input.UserGroupId = desired.ko.Spec.UserGroupID
 }
 
-// syncCacheUsageLimits handles updating the cache usage limits which may have restrictions
-func (rm *resourceManager) syncCacheUsageLimits(
-	ctx context.Context,
+// setCacheUsageLimits sets the cache usage limits which may have restrictions
+func setCacheUsageLimits(
+	input *svcsdk.ModifyServerlessCacheInput,
 	desired *resource,
-) error {
-	return rm.modifyServerlessCache(ctx, desired.ko.Spec.ServerlessCacheName, func(input *svcsdk.ModifyServerlessCacheInput) {
-		if desired.ko.Spec.CacheUsageLimits != nil {
-			f0 := &svcsdktypes.CacheUsageLimits{}
-			if desired.ko.Spec.CacheUsageLimits.DataStorage != nil {
-				f0f0 := &svcsdktypes.DataStorage{}
-				if desired.ko.Spec.CacheUsageLimits.DataStorage.Maximum != nil {
-					maximumCopy0 := *desired.ko.Spec.CacheUsageLimits.DataStorage.Maximum
-					maximumCopy := int32(maximumCopy0)
-					f0f0.Maximum = &maximumCopy
-				}
-				if desired.ko.Spec.CacheUsageLimits.DataStorage.Minimum != nil {
-					minimumCopy0 := *desired.ko.Spec.CacheUsageLimits.DataStorage.Minimum
-					minimumCopy := int32(minimumCopy0)
-					f0f0.Minimum = &minimumCopy
-				}
-				if desired.ko.Spec.CacheUsageLimits.DataStorage.Unit != nil {
-					f0f0.Unit = svcsdktypes.DataStorageUnit(*desired.ko.Spec.CacheUsageLimits.DataStorage.Unit)
-				}
-				f0.DataStorage = f0f0
+) {
+	if desired.ko.Spec.CacheUsageLimits != nil {
+		f0 := &svcsdktypes.CacheUsageLimits{}
+		if desired.ko.Spec.CacheUsageLimits.DataStorage != nil {
+			f0f0 := &svcsdktypes.DataStorage{}
+			if desired.ko.Spec.CacheUsageLimits.DataStorage.Maximum != nil {
+				maximumCopy0 := *desired.ko.Spec.CacheUsageLimits.DataStorage.Maximum
+				maximumCopy := int32(maximumCopy0)
+				f0f0.Maximum = &maximumCopy
+			}
+			if desired.ko.Spec.CacheUsageLimits.DataStorage.Minimum != nil {
+				minimumCopy0 := *desired.ko.Spec.CacheUsageLimits.DataStorage.Minimum
+				minimumCopy := int32(minimumCopy0)
+				f0f0.Minimum = &minimumCopy
 			}
-			if desired.ko.Spec.CacheUsageLimits.ECPUPerSecond != nil {
-				f0f1 := &svcsdktypes.ECPUPerSecond{}
-				if desired.ko.Spec.CacheUsageLimits.ECPUPerSecond.Maximum != nil {
-					maximumCopy0 := *desired.ko.Spec.CacheUsageLimits.ECPUPerSecond.Maximum
-					maximumCopy := int32(maximumCopy0)
-					f0f1.Maximum = &maximumCopy
-				}
-				if desired.ko.Spec.CacheUsageLimits.ECPUPerSecond.Minimum != nil {
-					minimumCopy0 := *desired.ko.Spec.CacheUsageLimits.ECPUPerSecond.Minimum
-					minimumCopy := int32(minimumCopy0)
-					f0f1.Minimum = &minimumCopy
-				}
-				f0.ECPUPerSecond = f0f1
+			if desired.ko.Spec.CacheUsageLimits.DataStorage.Unit != nil {
+				f0f0.Unit = svcsdktypes.DataStorageUnit(*desired.ko.Spec.CacheUsageLimits.DataStorage.Unit)
 			}
-			input.CacheUsageLimits = f0
+			f0.DataStorage = f0f0
 		}
-	})
+		if desired.ko.Spec.CacheUsageLimits.ECPUPerSecond != nil {
+			f0f1 := &svcsdktypes.ECPUPerSecond{}
+			if desired.ko.Spec.CacheUsageLimits.ECPUPerSecond.Maximum != nil {
+				maximumCopy0 := *desired.ko.Spec.CacheUsageLimits.ECPUPerSecond.Maximum
+				maximumCopy := int32(maximumCopy0)
+				f0f1.Maximum = &maximumCopy
+			}
+			if desired.ko.Spec.CacheUsageLimits.ECPUPerSecond.Minimum != nil {
+				minimumCopy0 := *desired.ko.Spec.CacheUsageLimits.ECPUPerSecond.Minimum
+				minimumCopy := int32(minimumCopy0)
+				f0f1.Minimum = &minimumCopy
+			}
+			f0.ECPUPerSecond = f0f1
+		}
+		input.CacheUsageLimits = f0
+	}
 }
 
-// syncEngineAndVersion handles the special case of engine and version changes
-func (rm *resourceManager) syncEngineAndVersion(
-	ctx context.Context,
+// setEngineAndVersion sets the engine and major engine version fields
+func setEngineAndVersion(
+	input *svcsdk.ModifyServerlessCacheInput,
 	desired *resource,
-) error {
-	return rm.modifyServerlessCache(ctx, desired.ko.Spec.ServerlessCacheName, func(input *svcsdk.ModifyServerlessCacheInput) {
-		input.Engine = desired.ko.Spec.Engine
-		input.MajorEngineVersion = desired.ko.Spec.MajorEngineVersion
-	})
+) {
+	input.Engine = desired.ko.Spec.Engine
+	input.MajorEngineVersion = desired.ko.Spec.MajorEngineVersion
 }
 
 func (rm *resourceManager) syncTags(
@@ -303,9 +331,155 @@ func (rm *resourceManager) syncTags(
 	return util.SyncTags(ctx, desired.ko.Spec.Tags, latest.ko.Spec.Tags, latest.ko.Status.ACKResourceMetadata, convertToOrderedACKTags, rm.sdkapi, rm.metrics)
 }
 
+// requeueUntilSnapshotsAvailable keeps the serverless cache from being created
+// until every Snapshot and ServerlessCacheSnapshot it is restored from is
+// available. A referenced snapshot resource that does not exist yet is waited
+// for like one that is not available.
+func requeueUntilSnapshotsAvailable(
+	ctx context.Context,
+	r *resource,
+) error {
+	kc := util.KubeClient()
+	if kc == nil {
+		return errors.New("no Kubernetes client to read referenced snapshots with")
+	}
+	for _, ref := range r.ko.Spec.SnapshotARNsToRestoreRefs {
+		if ref == nil || ref.From == nil || ref.From.Name == nil {
+			continue
+		}
+		name := referencedName(r, ref.From)
+		obj := &svcapitypes.ServerlessCacheSnapshot{}
+		if err := kc.Get(ctx, name, obj); apierrors.IsNotFound(err) {
+			return requeueWaitForSnapshot("ServerlessCacheSnapshot", name)
+		} else if err != nil {
+			return err
+		}
+		if obj.Status.Status == nil || *obj.Status.Status != snapshotStatusAvailable {
+			return requeueWaitForSnapshot("ServerlessCacheSnapshot", name)
+		}
+	}
+	for _, ref := range r.ko.Spec.NodeSnapshotARNsToRestoreRefs {
+		if ref == nil || ref.From == nil || ref.From.Name == nil {
+			continue
+		}
+		name := referencedName(r, ref.From)
+		obj := &svcapitypes.Snapshot{}
+		if err := kc.Get(ctx, name, obj); apierrors.IsNotFound(err) {
+			return requeueWaitForSnapshot("Snapshot", name)
+		} else if err != nil {
+			return err
+		}
+		if obj.Status.SnapshotStatus == nil || *obj.Status.SnapshotStatus != snapshotStatusAvailable {
+			return requeueWaitForSnapshot("Snapshot", name)
+		}
+	}
+	return nil
+}
+
+// referencedName returns the namespaced name of a referenced resource, which
+// defaults to the namespace of the serverless cache.
+func referencedName(
+	r *resource,
+	ref *ackv1alpha1.AWSResourceReference,
+) types.NamespacedName {
+	namespace := r.ko.ObjectMeta.GetNamespace()
+	if ref.Namespace != nil && *ref.Namespace != "" {
+		namespace = *ref.Namespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: *ref.Name}
+}
+
+// requeueWaitForSnapshot returns the requeue error of a serverless cache
+// waiting for a referenced snapshot.
+func requeueWaitForSnapshot(kind string, name types.NamespacedName) error {
+	return ackrequeue.NeededAfter(
+		fmt.Errorf("referenced %s %s is not available yet", kind, name),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+}
+
 func (rm *resourceManager) getTags(
 	ctx context.Context,
 	resourceARN string,
 ) ([]*svcapitypes.Tag, error) {
 	return util.GetTags(ctx, rm.sdkapi, rm.metrics, resourceARN)
 }
+
+// connectionDetails returns the connection details of the serverless cache,
+// with the endpoint and reader endpoint observed in latest.
+func connectionDetails(desired *resource, latest *resource) util.ConnectionDetails {
+	return util.ConnectionDetails{
+		Endpoint:       latest.ko.Status.Endpoint,
+		ReaderEndpoint: latest.ko.Status.ReaderEndpoint,
+		// serverless caches only accept TLS connections
+		TLS: true,
+	}
+}
+
+// modifyDelta adds a difference at Spec.ConnectionSecret to delta when the
+// connection Secret was not published with the endpoints of latest yet.
+func modifyDelta(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	if util.ConnectionSecretOutdated(latest.ko, desired.ko.Spec.ConnectionSecret, connectionDetails(desired, latest)) {
+		delta.Add("Spec.ConnectionSecret", desired.ko.Spec.ConnectionSecret, nil)
+	}
+}
+
+// recordEvents emits Kubernetes Events for the ElastiCache events of the
+// serverless cache that have not been emitted yet. Emitting events is best-effort, so
+// errors are logged rather than failing the read.
+func (rm *resourceManager) recordEvents(
+	ctx context.Context,
+	ko *svcapitypes.ServerlessCache,
+) {
+	events, err := util.DescribeEvents(
+		ctx, rm.sdkapi, rm.metrics, rm.awsAccountID, rm.awsRegion,
+		svcsdktypes.SourceTypeServerlessCache, ko.Spec.ServerlessCacheName,
+	)
+	if err != nil {
+		rm.log.V(1).Info("Error during DescribeEvents-ServerlessCache", "error", err)
+		return
+	}
+	util.RecordEvents(ko, events)
+}
+
+// describeFromCache returns a context in which the serverless cache is described from the
+// describe cache, if the serverless cache is available and the describe cache can serve it.
+// A serverless cache in any other state is described directly, so that its transitions are
+// observed without delay.
+func (rm *resourceManager) describeFromCache(
+	ctx context.Context,
+	r *resource,
+) context.Context {
+	if r.ko.Status.Status == nil || *r.ko.Status.Status != ServerlessCacheStatusAvailable {
+		return ctx
+	}
+	sc, ok, err := util.CachedServerlessCache(
+		ctx, rm.sdkapi, rm.metrics, rm.awsAccountID, rm.awsRegion, r.ko.Spec.ServerlessCacheName,
+	)
+	if err != nil {
+		rm.log.V(1).Info("Error during DescribeServerlessCaches for the describe cache", "error", err)
+		return ctx
+	}
+	if !ok || sc.Status == nil || *sc.Status != ServerlessCacheStatusAvailable {
+		return ctx
+	}
+	return util.WithCachedOutput(ctx, "DescribeServerlessCaches", &svcsdk.DescribeServerlessCachesOutput{
+		ServerlessCaches: []svcsdktypes.ServerlessCache{*sc},
+	})
+}
+
+// invalidateDescribeCache stops the serverless cache from being served from the
+// describe cache until it is listed again.
+func (rm *resourceManager) invalidateDescribeCache(r *resource) {
+	util.InvalidateDescribeCache(rm.awsAccountID, rm.awsRegion, r.ko.Spec.ServerlessCacheName)
+}
+
+// requeueWhileThrottled returns a requeue error while ElastiCache requests of
+// the account and region are throttled, and nil otherwise.
+func (rm *resourceManager) requeueWhileThrottled() error {
+	return util.RequeueWhileThrottled(rm.awsAccountID, rm.awsRegion)
+}
@@ -37,6 +37,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/elasticache-controller/pkg/util"
 )
 
 // Hack to avoid import errors during build...
@@ -71,6 +72,11 @@ func (rm *resourceManager) sdkFind(
 		return nil, ackerr.NotFound
 	}
 
+	if err := rm.requeueWhileThrottled(); err != nil {
+		return nil, err
+	}
+	ctx = rm.describeFromCache(ctx, r)
+
 	input, err := rm.newListRequestPayload(r)
 	if err != nil {
 		return nil, err
@@ -246,6 +252,9 @@ func (rm *resourceManager) sdkFind(
 			ko.Spec.Tags = tags
 		}
 	}
+	rm.recordEvents(ctx, ko)
+	util.ForgetDeletedConnectionSecret(ctx, ko, ko.Spec.ConnectionSecret)
+	endTransitionIfAvailable(&resource{ko})
 	return &resource{ko}, nil
 }
 
@@ -285,10 +294,14 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	if err := requeueUntilSnapshotsAvailable(ctx, desired); err != nil {
+		return nil, err
+	}
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
 	}
+	input.SnapshotArnsToRestore = append(input.SnapshotArnsToRestore, aws.ToStringSlice(desired.ko.Spec.NodeSnapshotARNsToRestore)...)
 
 	var resp *svcsdk.CreateServerlessCacheOutput
 	_ = resp
@@ -575,6 +588,9 @@ func (rm *resourceManager) sdkDelete(
 	defer func() {
 		exit(err)
 	}()
+	if err := util.RequeueIfDeletionProtected(r); err != nil {
+		return r, err
+	}
 	input, err := rm.newDeleteRequestPayload(r)
 	if err != nil {
 		return nil, err
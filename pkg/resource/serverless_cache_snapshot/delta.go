@@ -52,6 +52,9 @@ func newResourceDelta(
 	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.KMSKeyRef, b.ko.Spec.KMSKeyRef) {
 		delta.Add("Spec.KMSKeyRef", a.ko.Spec.KMSKeyRef, b.ko.Spec.KMSKeyRef)
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.S3BucketRef, b.ko.Spec.S3BucketRef) {
+		delta.Add("Spec.S3BucketRef", a.ko.Spec.S3BucketRef, b.ko.Spec.S3BucketRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.ServerlessCacheName, b.ko.Spec.ServerlessCacheName) {
 		delta.Add("Spec.ServerlessCacheName", a.ko.Spec.ServerlessCacheName, b.ko.Spec.ServerlessCacheName)
 	} else if a.ko.Spec.ServerlessCacheName != nil && b.ko.Spec.ServerlessCacheName != nil {
@@ -69,11 +72,22 @@ func newResourceDelta(
 			delta.Add("Spec.ServerlessCacheSnapshotName", a.ko.Spec.ServerlessCacheSnapshotName, b.ko.Spec.ServerlessCacheSnapshotName)
 		}
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.SourceSnapshotName, b.ko.Spec.SourceSnapshotName) {
+		delta.Add("Spec.SourceSnapshotName", a.ko.Spec.SourceSnapshotName, b.ko.Spec.SourceSnapshotName)
+	} else if a.ko.Spec.SourceSnapshotName != nil && b.ko.Spec.SourceSnapshotName != nil {
+		if *a.ko.Spec.SourceSnapshotName != *b.ko.Spec.SourceSnapshotName {
+			delta.Add("Spec.SourceSnapshotName", a.ko.Spec.SourceSnapshotName, b.ko.Spec.SourceSnapshotName)
+		}
+	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.SourceSnapshotRef, b.ko.Spec.SourceSnapshotRef) {
+		delta.Add("Spec.SourceSnapshotRef", a.ko.Spec.SourceSnapshotRef, b.ko.Spec.SourceSnapshotRef)
+	}
 	desiredACKTags, _ := convertToOrderedACKTags(a.ko.Spec.Tags)
 	latestACKTags, _ := convertToOrderedACKTags(b.ko.Spec.Tags)
 	if !ackcompare.MapStringStringEqual(desiredACKTags, latestACKTags) {
 		delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
 	}
 
+	modifyDelta(delta, a, b)
 	return delta
 }
@@ -20,20 +20,130 @@ import (
 
 	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
 	"github.com/aws-controllers-k8s/elasticache-controller/pkg/util"
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
 	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
 	ServerlessCacheSnapshotStatusAvailable = "available"
+
+	// exportStatusExporting and exportStatusFinished are the values of
+	// Status.ExportStatus while an export to S3 is in progress and once
+	// ElastiCache has finished processing it. ElastiCache does not report
+	// whether the export succeeded, for example when the bucket does not
+	// grant it access, so a finished export is not reported as completed.
+	exportStatusExporting = "exporting"
+	exportStatusFinished  = "finished"
 )
 
 var requeueWaitUntilCanModify = 10 * time.Second
 
+// CustomCreateServerlessCacheSnapshot copies the snapshot named by
+// Spec.SourceSnapshotName when it is set. It returns a nil resource otherwise,
+// in which case the snapshot is created from Spec.ServerlessCacheName.
+func (rm *resourceManager) CustomCreateServerlessCacheSnapshot(
+	ctx context.Context,
+	desired *resource,
+) (*resource, error) {
+	if desired.ko.Spec.SourceSnapshotName == nil {
+		if desired.ko.Spec.ServerlessCacheName == nil {
+			return nil, awserr.New("InvalidParameterCombination", "One of ServerlessCacheName "+
+				"or SourceSnapshotName must be specified", nil)
+		}
+		return nil, nil
+	}
+	if desired.ko.Spec.ServerlessCacheName != nil {
+		return nil, awserr.New("InvalidParameterCombination", "Cannot specify ServerlessCacheName "+
+			"while SourceSnapshotName is specified", nil)
+	}
+
+	input := rm.newCopyServerlessCacheSnapshotPayload(desired)
+	resp, err := rm.sdkapi.CopyServerlessCacheSnapshot(ctx, input)
+	rm.metrics.RecordAPICall("CREATE", "CopyServerlessCacheSnapshot", err)
+	if err != nil {
+		return nil, err
+	}
+
+	ko := desired.ko.DeepCopy()
+	setServerlessCacheSnapshotOutput(ko, resp.ServerlessCacheSnapshot)
+	rm.setStatusDefaults(ko)
+	// If tags are specified, mark the resource as needing a sync
+	if ko.Spec.Tags != nil {
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	}
+	return &resource{ko}, nil
+}
+
+// newCopyServerlessCacheSnapshotPayload returns an SDK-specific struct for the
+// HTTP request payload of the CopyServerlessCacheSnapshot API call
+func (rm *resourceManager) newCopyServerlessCacheSnapshotPayload(
+	r *resource,
+) *svcsdk.CopyServerlessCacheSnapshotInput {
+	res := &svcsdk.CopyServerlessCacheSnapshotInput{
+		SourceServerlessCacheSnapshotName: r.ko.Spec.SourceSnapshotName,
+		TargetServerlessCacheSnapshotName: r.ko.Spec.ServerlessCacheSnapshotName,
+		KmsKeyId:                          r.ko.Spec.KMSKeyID,
+	}
+	for _, tag := range r.ko.Spec.Tags {
+		res.Tags = append(res.Tags, svcsdktypes.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	return res
+}
+
+// setServerlessCacheSnapshotOutput merges the fields of the supplied
+// ServerlessCacheSnapshot returned by the API into ko.
+func setServerlessCacheSnapshotOutput(
+	ko *svcapitypes.ServerlessCacheSnapshot,
+	snapshot *svcsdktypes.ServerlessCacheSnapshot,
+) {
+	if snapshot == nil {
+		return
+	}
+	if ko.Status.ACKResourceMetadata == nil {
+		ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+	}
+	if snapshot.ARN != nil {
+		arn := ackv1alpha1.AWSResourceName(*snapshot.ARN)
+		ko.Status.ACKResourceMetadata.ARN = &arn
+	}
+	ko.Status.BytesUsedForCache = snapshot.BytesUsedForCache
+	if snapshot.CreateTime != nil {
+		ko.Status.CreateTime = &metav1.Time{Time: *snapshot.CreateTime}
+	}
+	if snapshot.ExpiryTime != nil {
+		ko.Status.ExpiryTime = &metav1.Time{Time: *snapshot.ExpiryTime}
+	}
+	if snapshot.KmsKeyId != nil {
+		ko.Spec.KMSKeyID = snapshot.KmsKeyId
+	}
+	if snapshot.ServerlessCacheConfiguration != nil {
+		ko.Status.ServerlessCacheConfiguration = &svcapitypes.ServerlessCacheConfiguration{
+			Engine:              snapshot.ServerlessCacheConfiguration.Engine,
+			MajorEngineVersion:  snapshot.ServerlessCacheConfiguration.MajorEngineVersion,
+			ServerlessCacheName: snapshot.ServerlessCacheConfiguration.ServerlessCacheName,
+		}
+	}
+	ko.Status.SnapshotType = snapshot.SnapshotType
+	ko.Status.Status = snapshot.Status
+}
+
 // customUpdateServerlessCacheSnapshot handles updates for serverless cache snapshots.
 // Since immutable fields are enforced by generator configuration, this method
-// only needs to handle tag updates.
+// only needs to handle tag updates and exports to S3.
 func (rm *resourceManager) customUpdateServerlessCacheSnapshot(
 	ctx context.Context,
 	desired *resource,
@@ -60,12 +170,105 @@ func (rm *resourceManager) customUpdateServerlessCacheSnapshot(
 		if err := rm.syncTags(ctx, desired, latest); err != nil {
 			return &resource{ko}, err
 		}
-		return &resource{ko}, nil
 	}
 
+	if delta.DifferentAt("Spec.S3BucketName") {
+		return rm.exportServerlessCacheSnapshot(ctx, ko)
+	}
+
+	if delta.DifferentAt("Spec.Tags") {
+		return &resource{ko}, nil
+	}
 	return latest, nil
 }
 
+// exportServerlessCacheSnapshot exports the snapshot to the S3 bucket named
+// by Spec.S3BucketName and records the export in the status of the supplied
+// object.
+func (rm *resourceManager) exportServerlessCacheSnapshot(
+	ctx context.Context,
+	ko *svcapitypes.ServerlessCacheSnapshot,
+) (*resource, error) {
+	input := &svcsdk.ExportServerlessCacheSnapshotInput{
+		ServerlessCacheSnapshotName: ko.Spec.ServerlessCacheSnapshotName,
+		S3BucketName:                ko.Spec.S3BucketName,
+	}
+	resp, err := rm.sdkapi.ExportServerlessCacheSnapshot(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ExportServerlessCacheSnapshot", err)
+	if err != nil {
+		return &resource{ko}, err
+	}
+
+	if resp.ServerlessCacheSnapshot != nil {
+		ko.Status.Status = resp.ServerlessCacheSnapshot.Status
+	}
+	ko.Status.ExportBucket = ko.Spec.S3BucketName
+	ko.Status.ExportStatus = aws.String(exportStatusExporting)
+	return &resource{ko}, nil
+}
+
+// setExportStatus marks an in-progress export as finished once the snapshot
+// is available again.
+func setExportStatus(ko *svcapitypes.ServerlessCacheSnapshot) {
+	if ko.Status.ExportStatus == nil || *ko.Status.ExportStatus != exportStatusExporting {
+		return
+	}
+	if isServerlessCacheSnapshotAvailable(&resource{ko}) {
+		ko.Status.ExportStatus = aws.String(exportStatusFinished)
+	}
+}
+
+// modifyDelta requests an export when Spec.S3BucketName names a bucket the
+// snapshot has not been exported to.
+func modifyDelta(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	target := desired.ko.Spec.S3BucketName
+	exported := latest.ko.Status.ExportBucket
+	if target != nil && (exported == nil || *target != *exported) {
+		delta.Add("Spec.S3BucketName", target, exported)
+	}
+}
+
+// resolveReferenceForS3BucketName reads the ACK S3 Bucket referenced from
+// the S3BucketRef field and sets the S3BucketName from the referenced
+// resource. Returns a boolean indicating whether a reference contains
+// references, or an error
+func (rm *resourceManager) resolveReferenceForS3BucketName(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.ServerlessCacheSnapshot,
+) (hasReferences bool, err error) {
+	if ko.Spec.S3BucketRef != nil && ko.Spec.S3BucketRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.S3BucketRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: S3BucketRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		bucketName, err := util.GetReferencedBucketName(ctx, apiReader, *arr.Name, namespace)
+		if err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.S3BucketName = &bucketName
+	}
+
+	return hasReferences, nil
+}
+
 // isServerlessCacheSnapshotAvailable returns true if the snapshot is in a state
 // that allows modifications (currently only tag updates)
 func isServerlessCacheSnapshotAvailable(r *resource) bool {
@@ -46,10 +46,18 @@ func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) ack
 		ko.Spec.KMSKeyID = nil
 	}
 
+	if ko.Spec.S3BucketRef != nil {
+		ko.Spec.S3BucketName = nil
+	}
+
 	if ko.Spec.ServerlessCacheRef != nil {
 		ko.Spec.ServerlessCacheName = nil
 	}
 
+	if ko.Spec.SourceSnapshotRef != nil {
+		ko.Spec.SourceSnapshotName = nil
+	}
+
 	return &resource{ko}
 }
 
@@ -75,12 +83,24 @@ func (rm *resourceManager) ResolveReferences(
 		resourceHasReferences = resourceHasReferences || fieldHasReferences
 	}
 
+	if fieldHasReferences, err := rm.resolveReferenceForS3BucketName(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForServerlessCacheName(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
 		resourceHasReferences = resourceHasReferences || fieldHasReferences
 	}
 
+	if fieldHasReferences, err := rm.resolveReferenceForSourceSnapshotName(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	return &resource{ko}, resourceHasReferences, err
 }
 
@@ -92,11 +112,16 @@ func validateReferenceFields(ko *svcapitypes.ServerlessCacheSnapshot) error {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("KMSKeyID", "KMSKeyRef")
 	}
 
+	if ko.Spec.S3BucketRef != nil && ko.Spec.S3BucketName != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("S3BucketName", "S3BucketRef")
+	}
+
 	if ko.Spec.ServerlessCacheRef != nil && ko.Spec.ServerlessCacheName != nil {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("ServerlessCacheName", "ServerlessCacheRef")
 	}
-	if ko.Spec.ServerlessCacheRef == nil && ko.Spec.ServerlessCacheName == nil {
-		return ackerr.ResourceReferenceOrIDRequiredFor("ServerlessCacheName", "ServerlessCacheRef")
+
+	if ko.Spec.SourceSnapshotRef != nil && ko.Spec.SourceSnapshotName != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("SourceSnapshotName", "SourceSnapshotRef")
 	}
 	return nil
 }
@@ -282,3 +307,94 @@ func getReferencedResourceState_ServerlessCache(
 	}
 	return nil
 }
+
+// resolveReferenceForSourceSnapshotName reads the resource referenced
+// from SourceSnapshotRef field and sets the SourceSnapshotName
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForSourceSnapshotName(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.ServerlessCacheSnapshot,
+) (hasReferences bool, err error) {
+	if ko.Spec.SourceSnapshotRef != nil && ko.Spec.SourceSnapshotRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.SourceSnapshotRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: SourceSnapshotRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.ServerlessCacheSnapshot{}
+		if err := getReferencedResourceState_ServerlessCacheSnapshot(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.SourceSnapshotName = (*string)(obj.Spec.ServerlessCacheSnapshotName)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_ServerlessCacheSnapshot looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_ServerlessCacheSnapshot(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.ServerlessCacheSnapshot,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"ServerlessCacheSnapshot",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"ServerlessCacheSnapshot",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"ServerlessCacheSnapshot",
+			namespace, name)
+	}
+	if obj.Spec.ServerlessCacheSnapshotName == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"ServerlessCacheSnapshot",
+			namespace, name,
+			"Spec.ServerlessCacheSnapshotName")
+	}
+	return nil
+}
@@ -167,6 +167,7 @@ func (rm *resourceManager) sdkFind(
 		}
 		ko.Spec.Tags = tags
 	}
+	setExportStatus(ko)
 	return &resource{ko}, nil
 }
 
@@ -212,6 +213,10 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	created, err = rm.CustomCreateServerlessCacheSnapshot(ctx, desired)
+	if created != nil || err != nil {
+		return created, err
+	}
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
@@ -487,7 +492,8 @@ func (rm *resourceManager) terminalAWSError(err error) bool {
 	}
 	switch terminalErr.ErrorCode() {
 	case "ServerlessCacheSnapshotAlreadyExistsFault",
-		"InvalidParameterValueException":
+		"InvalidParameterValueException",
+		"InvalidParameterCombination":
 		return true
 	default:
 		return false
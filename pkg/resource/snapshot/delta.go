@@ -94,6 +94,10 @@ func newResourceDelta(
 	if !ackcompare.MapStringStringEqual(desiredACKTags, latestACKTags) {
 		delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.TargetBucketRef, b.ko.Spec.TargetBucketRef) {
+		delta.Add("Spec.TargetBucketRef", a.ko.Spec.TargetBucketRef, b.ko.Spec.TargetBucketRef)
+	}
 
+	modifyDelta(delta, a, b)
 	return delta
 }
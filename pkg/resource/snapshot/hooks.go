@@ -15,10 +15,14 @@ package snapshot
 
 import (
 	"context"
+	"fmt"
 
 	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/elasticache-controller/pkg/util"
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/elasticache"
@@ -26,6 +30,19 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	snapshotStatusAvailable = "available"
+
+	// exportStatusExporting and exportStatusFinished are the values of
+	// Status.ExportStatus while an export to S3 is in progress and once
+	// ElastiCache has finished processing it. ElastiCache does not report
+	// whether the export succeeded, for example when the bucket does not
+	// grant it access, so a finished export is not reported as completed.
+	exportStatusExporting = "exporting"
+	exportStatusFinished  = "finished"
 )
 
 func (rm *resourceManager) CustomCreateSnapshot(
@@ -258,6 +275,7 @@ func (rm *resourceManager) CustomDescribeSnapshotSetOutput(
 	}
 	elem := resp.Snapshots[0]
 	rm.customSetOutput(r, &elem, ko)
+	setExportStatus(ko)
 	return ko, nil
 }
 
@@ -329,12 +347,109 @@ func (rm *resourceManager) customSetOutput(
 	}
 }
 
-// Snapshot API has no update
+// customUpdateSnapshot exports the snapshot to Spec.TargetBucket when it
+// has not been exported there yet. The Snapshot API has no other update.
 func (rm *resourceManager) customUpdateSnapshot(
 	ctx context.Context,
 	desired *resource,
 	latest *resource,
 	delta *ackcompare.Delta,
 ) (*resource, error) {
-	return latest, nil
+	if !delta.DifferentAt("Spec.TargetBucket") {
+		return latest, nil
+	}
+	if latest.ko.Status.SnapshotStatus == nil || *latest.ko.Status.SnapshotStatus != snapshotStatusAvailable {
+		return latest, ackrequeue.NeededAfter(
+			fmt.Errorf("snapshot must be available before it can be exported"),
+			ackrequeue.DefaultRequeueAfterDuration)
+	}
+	return rm.exportSnapshot(ctx, desired)
+}
+
+// exportSnapshot copies the snapshot to the S3 bucket named by
+// Spec.TargetBucket and records the export in the returned resource's status.
+func (rm *resourceManager) exportSnapshot(
+	ctx context.Context,
+	desired *resource,
+) (*resource, error) {
+	input := &svcsdk.CopySnapshotInput{
+		SourceSnapshotName: desired.ko.Spec.SnapshotName,
+		TargetSnapshotName: desired.ko.Spec.SnapshotName,
+		TargetBucket:       desired.ko.Spec.TargetBucket,
+	}
+	resp, err := rm.sdkapi.CopySnapshot(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "CopySnapshot", err)
+	if err != nil {
+		return nil, err
+	}
+
+	ko := desired.ko.DeepCopy()
+	rm.setStatusDefaults(ko)
+	ko.Status.SnapshotStatus = resp.Snapshot.SnapshotStatus
+	rm.customSetOutput(desired, resp.Snapshot, ko)
+	ko.Status.ExportBucket = desired.ko.Spec.TargetBucket
+	ko.Status.ExportStatus = aws.String(exportStatusExporting)
+	return &resource{ko}, nil
+}
+
+// setExportStatus marks an in-progress export as finished once the snapshot
+// is available again.
+func setExportStatus(ko *svcapitypes.Snapshot) {
+	if ko.Status.ExportStatus == nil || *ko.Status.ExportStatus != exportStatusExporting {
+		return
+	}
+	if ko.Status.SnapshotStatus != nil && *ko.Status.SnapshotStatus == snapshotStatusAvailable {
+		ko.Status.ExportStatus = aws.String(exportStatusFinished)
+	}
+}
+
+// modifyDelta requests an export when Spec.TargetBucket names a bucket the
+// snapshot has not been exported to.
+func modifyDelta(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	target := desired.ko.Spec.TargetBucket
+	exported := latest.ko.Status.ExportBucket
+	if target != nil && (exported == nil || *target != *exported) {
+		delta.Add("Spec.TargetBucket", target, exported)
+	}
+}
+
+// resolveReferenceForTargetBucket reads the ACK S3 Bucket referenced from
+// the TargetBucketRef field and sets the TargetBucket from the referenced
+// resource. Returns a boolean indicating whether a reference contains
+// references, or an error
+func (rm *resourceManager) resolveReferenceForTargetBucket(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.Snapshot,
+) (hasReferences bool, err error) {
+	if ko.Spec.TargetBucketRef != nil && ko.Spec.TargetBucketRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.TargetBucketRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: TargetBucketRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		bucketName, err := util.GetReferencedBucketName(ctx, apiReader, *arr.Name, namespace)
+		if err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.TargetBucket = &bucketName
+	}
+
+	return hasReferences, nil
 }
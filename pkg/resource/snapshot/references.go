@@ -58,6 +58,10 @@ func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) ack
 		ko.Spec.SourceSnapshotName = nil
 	}
 
+	if ko.Spec.TargetBucketRef != nil {
+		ko.Spec.TargetBucket = nil
+	}
+
 	return &resource{ko}
 }
 
@@ -101,6 +105,12 @@ func (rm *resourceManager) ResolveReferences(
 		resourceHasReferences = resourceHasReferences || fieldHasReferences
 	}
 
+	if fieldHasReferences, err := rm.resolveReferenceForTargetBucket(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	return &resource{ko}, resourceHasReferences, err
 }
 
@@ -123,6 +133,10 @@ func validateReferenceFields(ko *svcapitypes.Snapshot) error {
 	if ko.Spec.SourceSnapshotRef != nil && ko.Spec.SourceSnapshotName != nil {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("SourceSnapshotName", "SourceSnapshotRef")
 	}
+
+	if ko.Spec.TargetBucketRef != nil && ko.Spec.TargetBucket != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("TargetBucket", "TargetBucketRef")
+	}
 	return nil
 }
 
@@ -15,18 +15,43 @@ package user
 
 import (
 	"context"
+	"encoding/json"
+	"slices"
 
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 
 	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/elasticache-controller/pkg/acl"
 	"github.com/aws-controllers-k8s/elasticache-controller/pkg/common"
+	"github.com/aws-controllers-k8s/elasticache-controller/pkg/util"
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
 )
 
+const (
+	// AnnotationLastRequestedPasswords is an annotation whose value is a JSON representation of the secrets, and
+	// their versions, holding the passwords passed in as input to either the create or modify API called most
+	// recently
+	AnnotationLastRequestedPasswords = svcapitypes.AnnotationPrefix + "last-requested-password-secrets"
+	// annotationObservedPasswords is an annotation set on the latest observed resource only, whose value is a JSON
+	// representation of the secrets, and their versions, currently referenced by the passwords
+	annotationObservedPasswords = svcapitypes.AnnotationPrefix + "observed-password-secrets"
+)
+
+// maxPasswords is the number of passwords ElastiCache accepts for a user
+const maxPasswords = 2
+
+// passwordSecret is a secret referenced by the passwords of a user, along with the version of the secret
+type passwordSecret struct {
+	Ref     ackv1alpha1.SecretKeyReference `json:"ref"`
+	Version string                         `json:"version"`
+}
+
 // set the custom Status fields upon creation
 func (rm *resourceManager) CustomCreateUserSetOutput(
 	ctx context.Context,
@@ -34,6 +59,7 @@ func (rm *resourceManager) CustomCreateUserSetOutput(
 	resp *svcsdk.CreateUserOutput,
 	ko *svcapitypes.User,
 ) (*svcapitypes.User, error) {
+	rm.setLastRequestedPasswords(ctx, r, ko)
 	return rm.CustomSetOutput(r, resp.AccessString, ko)
 }
 
@@ -46,6 +72,7 @@ func (rm *resourceManager) CustomModifyUserSetOutput(
 	resp *svcsdk.ModifyUserOutput,
 	ko *svcapitypes.User,
 ) (*svcapitypes.User, error) {
+	rm.setLastRequestedPasswords(ctx, r, ko)
 	return rm.CustomSetOutput(r, resp.AccessString, ko)
 }
 
@@ -64,7 +91,8 @@ func (rm *resourceManager) CustomSetOutput(
 	return ko, nil
 }
 
-// currently this function's only purpose is to requeue if the resource is currently unavailable
+// CustomModifyUser requeues if the resource is currently unavailable, and records the current passwords of a user
+// created before passwords were tracked instead of pushing them again
 func (rm *resourceManager) CustomModifyUser(
 	ctx context.Context,
 	desired *resource,
@@ -80,6 +108,15 @@ func (rm *resourceManager) CustomModifyUser(
 			requeue.DefaultRequeueAfterDuration)
 	}
 
+	lastRequested := desired.ko.GetAnnotations()
+	if _, ok := lastRequested[AnnotationLastRequestedPasswords]; !ok &&
+		delta.DifferentAt("Spec.Passwords") && !delta.DifferentExcept("Spec.Passwords") {
+		// the current passwords are taken as the ones ElastiCache knows
+		ko := desired.ko.DeepCopy()
+		setAnnotation(ko, AnnotationLastRequestedPasswords, latest.ko.GetAnnotations()[annotationObservedPasswords])
+		return &resource{ko}, nil
+	}
+
 	return nil, nil
 }
 
@@ -93,6 +130,9 @@ func (rm *resourceManager) populateUpdatePayload(
 	delta *ackcompare.Delta,
 ) error {
 	if delta.DifferentAt("Spec.AccessString") && r.ko.Spec.AccessString != nil {
+		if err := validateAccessString(r); err != nil {
+			return err
+		}
 		input.AccessString = r.ko.Spec.AccessString
 	}
 
@@ -100,6 +140,22 @@ func (rm *resourceManager) populateUpdatePayload(
 		input.NoPasswordRequired = r.ko.Spec.NoPasswordRequired
 	}
 
+	// passwords set in AuthenticationMode are always part of the generated payload, they are replaced so that a
+	// rotation in progress is not cut short
+	if hasAuthenticationModePasswords(r) && input.AuthenticationMode != nil {
+		passwords, err := rm.requestedPasswords(ctx, r)
+		if err != nil {
+			return requeue.Needed(err)
+		}
+		input.AuthenticationMode.Passwords = passwords
+	} else if delta.DifferentAt("Spec.Passwords") {
+		passwords, err := rm.requestedPasswords(ctx, r)
+		if err != nil {
+			return requeue.Needed(err)
+		}
+		input.Passwords = passwords
+	}
+
 	return nil
 }
 
@@ -153,14 +209,239 @@ func filterDelta(
 	desired *resource,
 	latest *resource,
 ) {
-	// the returned AccessString can be different than the specified one; as long as the last requested AccessString
-	// matches the currently desired one, remove this difference from the delta
-	if delta.DifferentAt("Spec.AccessString") {
-		if desired.ko.Spec.AccessString != nil &&
-			desired.ko.Status.LastRequestedAccessString != nil &&
-			*desired.ko.Spec.AccessString == *desired.ko.Status.LastRequestedAccessString {
-
-			common.RemoveFromDelta(delta, "Spec.AccessString")
+	// the returned AccessString is an expanded form of the specified one; remove this difference from the delta
+	// as long as both grant the same access
+	if delta.DifferentAt("Spec.AccessString") && accessStringMatches(desired, latest) {
+		common.RemoveFromDelta(delta, "Spec.AccessString")
+	}
+
+	// Passwords are secrets and cannot be compared, instead the versions of the secrets currently referenced by the
+	// passwords are compared to the versions of the secrets holding the last requested passwords. See
+	// requestedPasswordSecrets for how the passwords are rotated.
+	if passwordsRequireUpdate(desired, latest) {
+		delta.Add("Spec.Passwords", desired.ko.Spec.Passwords, nil)
+	}
+}
+
+// validateAccessString returns a terminal error if the access string of the user is malformed
+func validateAccessString(r *resource) error {
+	if r.ko.Spec.AccessString == nil {
+		return nil
+	}
+	if _, err := acl.Parse(*r.ko.Spec.AccessString); err != nil {
+		return ackerr.NewTerminalError(err)
+	}
+	return nil
+}
+
+// accessStringMatches returns true if the access string reported by ElastiCache grants the access specified in
+// desired. It is compared semantically with the specified access string and, as long as the specified access string
+// is the last requested one, with the expanded access string ElastiCache reported when it was requested. Any other
+// difference is drift, such as ACL changes made outside of the controller.
+func accessStringMatches(desired *resource, latest *resource) bool {
+	specified := desired.ko.Spec.AccessString
+	observed := latest.ko.Spec.AccessString
+	if specified == nil || observed == nil {
+		return false
+	}
+	if equivalent, err := acl.Equivalent(*specified, *observed); err == nil && equivalent {
+		return true
+	}
+
+	lastRequested := desired.ko.Status.LastRequestedAccessString
+	lastExpanded := desired.ko.Status.ExpandedAccessString
+	if lastRequested == nil || lastExpanded == nil || *specified != *lastRequested {
+		return false
+	}
+	if equivalent, err := acl.Equivalent(*lastExpanded, *observed); err == nil {
+		return equivalent
+	}
+	return *lastExpanded == *observed
+}
+
+// passwordsRequireUpdate returns true if the secrets referenced by the passwords differ from the ones holding the
+// last requested passwords
+func passwordsRequireUpdate(desired *resource, latest *resource) bool {
+	observed, ok := latest.ko.GetAnnotations()[annotationObservedPasswords]
+	if !ok {
+		return false
+	}
+	return observed != desired.ko.GetAnnotations()[AnnotationLastRequestedPasswords]
+}
+
+// hasAuthenticationModePasswords returns true if the passwords of the user are set in AuthenticationMode, which
+// then take precedence over Passwords
+func hasAuthenticationModePasswords(r *resource) bool {
+	return r.ko.Spec.AuthenticationMode != nil && len(r.ko.Spec.AuthenticationMode.Passwords) > 0
+}
+
+// passwordRefs returns the references to the secrets holding the passwords of the user
+func passwordRefs(r *resource) []*ackv1alpha1.SecretKeyReference {
+	if hasAuthenticationModePasswords(r) {
+		return r.ko.Spec.AuthenticationMode.Passwords
+	}
+	return r.ko.Spec.Passwords
+}
+
+// passwordSecrets returns the secrets currently referenced by the passwords of the user, along with their version.
+// References without a namespace are resolved to the namespace of the user.
+func passwordSecrets(
+	ctx context.Context,
+	r *resource,
+) ([]passwordSecret, error) {
+	secrets := []passwordSecret{}
+	for _, ref := range passwordRefs(r) {
+		if ref == nil {
+			continue
+		}
+		resolved := *ref
+		if resolved.Namespace == "" {
+			resolved.Namespace = r.ko.Namespace
+		}
+		version, err := util.SecretVersion(ctx, &resolved, r.ko.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, passwordSecret{Ref: resolved, Version: version})
+	}
+	return secrets, nil
+}
+
+// lastRequestedPasswordSecrets returns the secrets holding the last requested passwords of the user
+func lastRequestedPasswordSecrets(r *resource) []passwordSecret {
+	secrets := []passwordSecret{}
+	value, ok := r.ko.GetAnnotations()[AnnotationLastRequestedPasswords]
+	if !ok || json.Unmarshal([]byte(value), &secrets) != nil {
+		return nil
+	}
+	return secrets
+}
+
+// requestedPasswordSecrets returns the secrets holding the passwords to pass to ModifyUser. Passwords are rotated
+// without downtime in two steps: the passwords of newly referenced secrets are first added next to the last
+// requested ones, and the passwords no longer referenced are dropped on a later update, once the user is active
+// again. A last requested password is only kept while its value is unchanged, so a password updated in place in
+// its secret cannot be kept and is replaced right away, as are the passwords that would exceed maxPasswords.
+func (rm *resourceManager) requestedPasswordSecrets(
+	ctx context.Context,
+	r *resource,
+) ([]passwordSecret, error) {
+	current, err := passwordSecrets(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	lastRequested := lastRequestedPasswordSecrets(r)
+	added := false
+	for _, secret := range current {
+		if !slices.Contains(lastRequested, secret) {
+			added = true
+			break
+		}
+	}
+	if !added {
+		// the current passwords were already added next to the old ones, which are dropped now
+		return current, nil
+	}
+	requested := current
+	for _, secret := range lastRequested {
+		if slices.Contains(current, secret) {
+			continue
+		}
+		version, err := util.SecretVersion(ctx, &secret.Ref, r.ko.Namespace)
+		if err != nil || version != secret.Version {
+			// the old password can no longer be read as it was requested
+			continue
 		}
+		requested = append(requested, secret)
+	}
+	if len(requested) > maxPasswords {
+		return current, nil
+	}
+	return requested, nil
+}
+
+// requestedPasswords reads the passwords to pass to ModifyUser from the secrets returned by
+// requestedPasswordSecrets
+func (rm *resourceManager) requestedPasswords(
+	ctx context.Context,
+	r *resource,
+) ([]string, error) {
+	secrets, err := rm.requestedPasswordSecrets(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	passwords := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		password, err := rm.rr.SecretValueFromReference(ctx, &secret.Ref)
+		if err != nil {
+			return nil, err
+		}
+		passwords = append(passwords, password)
+	}
+	return passwords, nil
+}
+
+// setLastRequestedPasswords records the secrets holding the passwords passed in as input to the create or modify
+// API
+func (rm *resourceManager) setLastRequestedPasswords(
+	ctx context.Context,
+	r *resource,
+	ko *svcapitypes.User,
+) {
+	secrets, err := rm.requestedPasswordSecrets(ctx, r)
+	if err != nil {
+		return
+	}
+	value, err := json.Marshal(secrets)
+	if err != nil {
+		return
+	}
+	setAnnotation(ko, AnnotationLastRequestedPasswords, string(value))
+}
+
+// setObservedPasswords records the secrets currently referenced by the passwords on the latest observed resource.
+// Secrets that cannot be read are logged and skipped, so that they never block reads of the user.
+func (rm *resourceManager) setObservedPasswords(
+	ctx context.Context,
+	ko *svcapitypes.User,
+) {
+	secrets, err := passwordSecrets(ctx, &resource{ko})
+	if err != nil {
+		rm.log.V(1).Info("unable to read password secrets", "error", err)
+		return
+	}
+	value, err := json.Marshal(secrets)
+	if err != nil {
+		return
+	}
+	setAnnotation(ko, annotationObservedPasswords, string(value))
+}
+
+func setAnnotation(
+	ko *svcapitypes.User,
+	key string,
+	value string,
+) {
+	if ko.ObjectMeta.Annotations == nil {
+		ko.ObjectMeta.Annotations = map[string]string{}
+	}
+	ko.ObjectMeta.Annotations[key] = value
+}
+
+// recordEvents emits Kubernetes Events for the ElastiCache events of the
+// user that have not been emitted yet. Emitting events is best-effort, so
+// errors are logged rather than failing the read.
+func (rm *resourceManager) recordEvents(
+	ctx context.Context,
+	ko *svcapitypes.User,
+) {
+	events, err := util.DescribeEvents(
+		ctx, rm.sdkapi, rm.metrics, rm.awsAccountID, rm.awsRegion,
+		svcsdktypes.SourceTypeUser, ko.Spec.UserID,
+	)
+	if err != nil {
+		rm.log.V(1).Info("Error during DescribeEvents-User", "error", err)
+		return
 	}
+	util.RecordEvents(ko, events)
 }
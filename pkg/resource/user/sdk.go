@@ -161,6 +161,8 @@ func (rm *resourceManager) sdkFind(
 			ko.Spec.AuthenticationMode.Type = &authType
 		}
 	}
+	rm.setObservedPasswords(ctx, ko)
+	rm.recordEvents(ctx, ko)
 
 	return &resource{ko}, nil
 }
@@ -201,6 +203,9 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	if err = validateAccessString(desired); err != nil {
+		return nil, err
+	}
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,125 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrlrtfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/elasticache-controller/pkg/util"
+)
+
+func Test_requestedPasswordSecrets(t *testing.T) {
+	ctx := context.Background()
+	kc := ctrlrtfake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "old", UID: "old"},
+			Data:       map[string][]byte{"password": []byte("old-password")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "new", UID: "new"},
+			Data:       map[string][]byte{"password": []byte("new-password")},
+		},
+	).Build()
+	util.SetAPIReader(kc)
+	defer util.SetAPIReader(nil)
+
+	ref := func(name string) *ackv1alpha1.SecretKeyReference {
+		return &ackv1alpha1.SecretKeyReference{
+			SecretReference: corev1.SecretReference{Name: name},
+			Key:             "password",
+		}
+	}
+	user := func(lastRequested []passwordSecret, refs ...*ackv1alpha1.SecretKeyReference) *resource {
+		ko := &svcapitypes.User{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "user"},
+			Spec:       svcapitypes.UserSpec{Passwords: refs},
+		}
+		if lastRequested != nil {
+			value, _ := json.Marshal(lastRequested)
+			setAnnotation(ko, AnnotationLastRequestedPasswords, string(value))
+		}
+		return &resource{ko}
+	}
+	names := func(secrets []passwordSecret) []string {
+		names := []string{}
+		for _, secret := range secrets {
+			names = append(names, secret.Ref.Name)
+		}
+		return names
+	}
+
+	rm := &resourceManager{}
+	old, err := passwordSecrets(ctx, user(nil, ref("old")))
+	if err != nil {
+		t.Fatalf("passwordSecrets() error = %v", err)
+	}
+
+	// The new password is added next to the old one first.
+	added, err := rm.requestedPasswordSecrets(ctx, user(old, ref("new")))
+	if err != nil {
+		t.Fatalf("requestedPasswordSecrets() error = %v", err)
+	}
+	if got := names(added); len(got) != 2 || got[0] != "new" || got[1] != "old" {
+		t.Fatalf("requestedPasswordSecrets() = %v, want [new old]", got)
+	}
+
+	// The old password is dropped on the next update.
+	dropped, err := rm.requestedPasswordSecrets(ctx, user(added, ref("new")))
+	if err != nil {
+		t.Fatalf("requestedPasswordSecrets() error = %v", err)
+	}
+	if got := names(dropped); len(got) != 1 || got[0] != "new" {
+		t.Errorf("requestedPasswordSecrets() = %v, want [new]", got)
+	}
+
+	// An old password is kept while the metadata of its secret changes.
+	secret := &corev1.Secret{}
+	if err := kc.Get(ctx, types.NamespacedName{Namespace: "default", Name: "old"}, secret); err != nil {
+		t.Fatal(err)
+	}
+	secret.Labels = map[string]string{"team": "cache"}
+	if err := kc.Update(ctx, secret); err != nil {
+		t.Fatal(err)
+	}
+	kept, err := rm.requestedPasswordSecrets(ctx, user(old, ref("new")))
+	if err != nil {
+		t.Fatalf("requestedPasswordSecrets() error = %v", err)
+	}
+	if got := names(kept); len(got) != 2 {
+		t.Errorf("requestedPasswordSecrets() = %v, want [new old]", got)
+	}
+
+	// An old password updated in place can no longer be kept.
+	secret.Data = map[string][]byte{"password": []byte("changed")}
+	if err := kc.Update(ctx, secret); err != nil {
+		t.Fatal(err)
+	}
+	replaced, err := rm.requestedPasswordSecrets(ctx, user(old, ref("new")))
+	if err != nil {
+		t.Fatalf("requestedPasswordSecrets() error = %v", err)
+	}
+	if got := names(replaced); len(got) != 1 || got[0] != "new" {
+		t.Errorf("requestedPasswordSecrets() = %v, want [new]", got)
+	}
+}
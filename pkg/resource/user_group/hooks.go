@@ -20,6 +20,7 @@ import (
 	"slices"
 
 	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/elasticache-controller/pkg/util"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
 	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -60,6 +61,9 @@ func (rm *resourceManager) CustomDescribeUserGroupsSetOutput(
 	ko *svcapitypes.UserGroup,
 ) (*svcapitypes.UserGroup, error) {
 	rm.patchUserGroupPendingChanges(ko)
+	if isActive(ko) {
+		util.EndTransition("UserGroup", ko)
+	}
 	return ko, nil
 }
 
@@ -100,7 +104,7 @@ func (rm *resourceManager) patchUserGroupPendingChanges(
 	return ko, nil
 }
 
-func requeueWaitUntilCanModify(r *resource) *ackrequeue.RequeueNeededAfter {
+func (rm *resourceManager) requeueWaitUntilCanModify(r *resource) *ackrequeue.RequeueNeededAfter {
 	if r.ko.Status.Status == nil {
 		return nil
 	}
@@ -109,9 +113,9 @@ func requeueWaitUntilCanModify(r *resource) *ackrequeue.RequeueNeededAfter {
 		"User group in '%s' state, cannot be modified until '%s'.",
 		status, statusActive,
 	)
-	return ackrequeue.NeededAfter(
-		errors.New(msg),
-		ackrequeue.DefaultRequeueAfterDuration,
+	return util.RequeueWaitWhile(
+		errors.New(msg), "UserGroup", r.ko, util.TransitionModifying,
+		rm.awsAccountID, rm.awsRegion,
 	)
 }
 
@@ -134,3 +138,9 @@ func getUserIdsDifferences(userIdsDesired []*string, userIdsLatest []*string) ([
 	return userIdsToAdd, userIdsToRemove
 
 }
+
+// requeueWhileThrottled returns a requeue error while ElastiCache requests of
+// the account and region are throttled, and nil otherwise.
+func (rm *resourceManager) requeueWhileThrottled() error {
+	return util.RequeueWhileThrottled(rm.awsAccountID, rm.awsRegion)
+}
@@ -70,6 +70,9 @@ func (rm *resourceManager) sdkFind(
 		return nil, ackerr.NotFound
 	}
 
+	if err := rm.requeueWhileThrottled(); err != nil {
+		return nil, err
+	}
 	input, err := rm.newListRequestPayload(r)
 	if err != nil {
 		return nil, err
@@ -311,7 +314,7 @@ func (rm *resourceManager) sdkUpdate(
 		exit(err)
 	}()
 	if !isActive(latest.ko) {
-		return nil, requeueWaitUntilCanModify(latest)
+		return nil, rm.requeueWaitUntilCanModify(latest)
 	}
 	input, err := rm.newUpdateRequestPayload(ctx, desired, delta)
 	if err != nil {
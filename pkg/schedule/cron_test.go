@@ -0,0 +1,114 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_ParseCron_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"@every 1h",
+	} {
+		if _, err := ParseCron(expr); err == nil {
+			t.Errorf("ParseCron(%q) expected an error", expr)
+		}
+	}
+}
+
+func Test_Cron_Next(t *testing.T) {
+	from := time.Date(2024, time.January, 31, 10, 17, 30, 0, time.UTC) // a Wednesday
+	tests := []struct {
+		name string
+		expr string
+		want time.Time
+	}{
+		{
+			name: "Every Minute",
+			expr: "* * * * *",
+			want: time.Date(2024, time.January, 31, 10, 18, 0, 0, time.UTC),
+		},
+		{
+			name: "Hourly",
+			expr: "@hourly",
+			want: time.Date(2024, time.January, 31, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Step",
+			expr: "*/15 * * * *",
+			want: time.Date(2024, time.January, 31, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "Range And List",
+			expr: "0 9-17/4,22 * * *",
+			want: time.Date(2024, time.January, 31, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Month Rollover",
+			expr: "0 0 1 * *",
+			want: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Leap Day",
+			expr: "0 0 29 feb *",
+			want: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Day Of Week",
+			expr: "30 2 * * sun",
+			want: time.Date(2024, time.February, 4, 2, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "Sunday As Seven",
+			expr: "30 2 * * 7",
+			want: time.Date(2024, time.February, 4, 2, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "Day Of Month Or Day Of Week",
+			expr: "0 0 15 * fri",
+			want: time.Date(2024, time.February, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseCron(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCron(%q) error = %v", tt.expr, err)
+			}
+			if got := c.Next(from); !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Cron_Next_Never(t *testing.T) {
+	c, err := ParseCron("0 0 31 feb *")
+	if err != nil {
+		t.Fatalf("ParseCron error = %v", err)
+	}
+	if got := c.Next(time.Now()); !got.IsZero() {
+		t.Errorf("Next() = %v, want zero time", got)
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	ctrlrtbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlrtlog "sigs.k8s.io/controller-runtime/pkg/log"
+	ctrlrtpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	ctrlrtreconcile "sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=elasticache.services.k8s.aws,resources=snapshotschedules,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=elasticache.services.k8s.aws,resources=snapshotschedules/status,verbs=get;update;patch
+
+// LabelSnapshotSchedule is the label set on the Snapshot and
+// ServerlessCacheSnapshot resources created by a SnapshotSchedule. Its value
+// is the name of the SnapshotSchedule.
+const LabelSnapshotSchedule = svcapitypes.AnnotationPrefix + "snapshot-schedule"
+
+// snapshotNameTimeFormat is the format of the creation time appended to the
+// name of the SnapshotSchedule to name each snapshot it creates.
+const snapshotNameTimeFormat = "20060102-1504"
+
+// snapshotNameUIDLength is the number of characters of the UID of the
+// SnapshotSchedule in the name of each snapshot it creates.
+const snapshotNameUIDLength = 8
+
+// snapshotScheduleReconciler creates snapshot resources on the schedule of
+// each SnapshotSchedule and prunes the ones it created before.
+type snapshotScheduleReconciler struct {
+	kc  ctrlrtclient.Client
+	now func() time.Time
+}
+
+// SetupSnapshotScheduleController registers the SnapshotSchedule controller
+// with the controller manager.
+//
+// SnapshotSchedule is not backed by an ElastiCache API resource, so it is
+// reconciled by a plain controller-runtime controller rather than by the ACK
+// runtime. The snapshots it creates are ordinary Snapshot and
+// ServerlessCacheSnapshot resources managed by their ACK controllers.
+func SetupSnapshotScheduleController(mgr ctrlrt.Manager) error {
+	return ctrlrt.NewControllerManagedBy(
+		mgr,
+	).For(
+		&svcapitypes.SnapshotSchedule{},
+		// status updates made by the reconciler must not trigger it again
+		ctrlrtbuilder.WithPredicates(ctrlrtpredicate.GenerationChangedPredicate{}),
+	).Complete(&snapshotScheduleReconciler{
+		kc:  mgr.GetClient(),
+		now: time.Now,
+	})
+}
+
+// Reconcile creates a snapshot when the schedule has fired since the last one
+// was created, deletes the snapshots beyond the retention limits and requeues
+// the SnapshotSchedule for the next time the schedule fires or the oldest
+// snapshot expires, whichever comes first. Runs of the schedule missed while
+// the controller was not running are collapsed into a single snapshot.
+func (r *snapshotScheduleReconciler) Reconcile(
+	ctx context.Context,
+	req ctrlrtreconcile.Request,
+) (ctrlrtreconcile.Result, error) {
+	schedule := &svcapitypes.SnapshotSchedule{}
+	if err := r.kc.Get(ctx, req.NamespacedName, schedule); err != nil {
+		return ctrlrtreconcile.Result{}, ctrlrtclient.IgnoreNotFound(err)
+	}
+	if !schedule.DeletionTimestamp.IsZero() {
+		return ctrlrtreconcile.Result{}, nil
+	}
+
+	var cron *Cron
+	var err error
+	if schedule.Spec.Schedule != nil {
+		cron, err = ParseCron(*schedule.Spec.Schedule)
+	} else {
+		err = fmt.Errorf("schedule must be specified")
+	}
+	if err != nil {
+		setTerminalCondition(schedule, err)
+		schedule.Status.NextScheduleTime = nil
+		return ctrlrtreconcile.Result{}, r.kc.Status().Update(ctx, schedule)
+	}
+	setTerminalCondition(schedule, nil)
+
+	now := r.now().UTC()
+	last := schedule.CreationTimestamp.Time
+	if schedule.Status.LastScheduleTime != nil {
+		last = schedule.Status.LastScheduleTime.Time
+	}
+	if next := cron.Next(last); !next.IsZero() && !next.After(now) {
+		name, err := r.createSnapshot(ctx, schedule, now)
+		if err != nil {
+			return ctrlrtreconcile.Result{}, err
+		}
+		ctrlrtlog.FromContext(ctx).Info("created scheduled snapshot", "name", name)
+		schedule.Status.LastScheduleTime = &metav1.Time{Time: now.Truncate(time.Minute)}
+		schedule.Status.LastSnapshotName = &name
+	}
+
+	nextExpiry, err := r.pruneSnapshots(ctx, schedule, now)
+	if err != nil {
+		return ctrlrtreconcile.Result{}, err
+	}
+
+	result := ctrlrtreconcile.Result{}
+	schedule.Status.NextScheduleTime = nil
+	if next := cron.Next(now); !next.IsZero() {
+		schedule.Status.NextScheduleTime = &metav1.Time{Time: next}
+		result.RequeueAfter = next.Sub(now)
+	}
+	if !nextExpiry.IsZero() && (result.RequeueAfter == 0 || nextExpiry.Sub(now) < result.RequeueAfter) {
+		result.RequeueAfter = nextExpiry.Sub(now)
+	}
+	return result, r.kc.Status().Update(ctx, schedule)
+}
+
+// createSnapshot creates the Snapshot or ServerlessCacheSnapshot for the
+// target of the schedule and returns its name, which is also used as the
+// name of the snapshot in ElastiCache.
+func (r *snapshotScheduleReconciler) createSnapshot(
+	ctx context.Context,
+	schedule *svcapitypes.SnapshotSchedule,
+	now time.Time,
+) (string, error) {
+	name := snapshotName(schedule, now)
+	objectMeta := metav1.ObjectMeta{
+		Name:      name,
+		Namespace: schedule.Namespace,
+		Labels: map[string]string{
+			LabelSnapshotSchedule: schedule.Name,
+		},
+	}
+	spec := schedule.Spec.DeepCopy()
+	var obj ctrlrtclient.Object
+	switch {
+	case spec.ServerlessCacheRef != nil:
+		obj = &svcapitypes.ServerlessCacheSnapshot{
+			ObjectMeta: objectMeta,
+			Spec: svcapitypes.ServerlessCacheSnapshotSpec{
+				ServerlessCacheRef:          spec.ServerlessCacheRef,
+				ServerlessCacheSnapshotName: &name,
+			},
+		}
+	case spec.ReplicationGroupRef != nil:
+		obj = &svcapitypes.Snapshot{
+			ObjectMeta: objectMeta,
+			Spec: svcapitypes.SnapshotSpec{
+				ReplicationGroupRef: spec.ReplicationGroupRef,
+				SnapshotName:        &name,
+			},
+		}
+	case spec.CacheClusterRef != nil:
+		obj = &svcapitypes.Snapshot{
+			ObjectMeta: objectMeta,
+			Spec: svcapitypes.SnapshotSpec{
+				CacheClusterRef: spec.CacheClusterRef,
+				SnapshotName:    &name,
+			},
+		}
+	default:
+		return "", fmt.Errorf("one of cacheClusterRef, replicationGroupRef or serverlessCacheRef must be specified")
+	}
+	// the snapshot may already have been created by an earlier reconcile
+	// whose status update failed
+	if err := r.kc.Create(ctx, obj); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", err
+	}
+	return name, nil
+}
+
+// snapshotName returns the name of the snapshot created by the schedule at
+// the supplied time. Snapshot names are unique per account and region in
+// ElastiCache, so the name includes part of the UID of the SnapshotSchedule
+// to keep schedules of the same name in different namespaces, or recreated
+// ones, from creating snapshots of the same name.
+func snapshotName(schedule *svcapitypes.SnapshotSchedule, now time.Time) string {
+	uid := string(schedule.UID)
+	if len(uid) > snapshotNameUIDLength {
+		uid = uid[:snapshotNameUIDLength]
+	}
+	return schedule.Name + "-" + uid + "-" + now.Format(snapshotNameTimeFormat)
+}
+
+// pruneSnapshots deletes the snapshots created by the schedule that are
+// beyond Spec.RetentionCount or older than Spec.RetentionPeriod, and returns
+// when the oldest of the remaining snapshots expires, or the zero time if
+// none of them expires.
+func (r *snapshotScheduleReconciler) pruneSnapshots(
+	ctx context.Context,
+	schedule *svcapitypes.SnapshotSchedule,
+	now time.Time,
+) (time.Time, error) {
+	var nextExpiry time.Time
+	if schedule.Spec.RetentionCount == nil && schedule.Spec.RetentionPeriod == nil {
+		return nextExpiry, nil
+	}
+	var list ctrlrtclient.ObjectList = &svcapitypes.SnapshotList{}
+	if schedule.Spec.ServerlessCacheRef != nil {
+		list = &svcapitypes.ServerlessCacheSnapshotList{}
+	}
+	if err := r.kc.List(
+		ctx, list,
+		ctrlrtclient.InNamespace(schedule.Namespace),
+		ctrlrtclient.MatchingLabels{LabelSnapshotSchedule: schedule.Name},
+	); err != nil {
+		return nextExpiry, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nextExpiry, err
+	}
+	snapshots := make([]ctrlrtclient.Object, 0, len(items))
+	for _, item := range items {
+		if obj, ok := item.(ctrlrtclient.Object); ok && obj.GetDeletionTimestamp().IsZero() {
+			snapshots = append(snapshots, obj)
+		}
+	}
+	// newest first
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].GetCreationTimestamp().Time.After(snapshots[j].GetCreationTimestamp().Time)
+	})
+	for i, obj := range snapshots {
+		var expiry time.Time
+		if schedule.Spec.RetentionPeriod != nil {
+			expiry = obj.GetCreationTimestamp().Time.Add(schedule.Spec.RetentionPeriod.Duration)
+		}
+		expired := !expiry.IsZero() && !now.Before(expiry)
+		excess := schedule.Spec.RetentionCount != nil && int64(i) >= *schedule.Spec.RetentionCount
+		if !expired && !excess {
+			// snapshots are sorted newest first, so the last one kept is
+			// the first to expire
+			nextExpiry = expiry
+			continue
+		}
+		if err := r.kc.Delete(ctx, obj); ctrlrtclient.IgnoreNotFound(err) != nil {
+			return nextExpiry, err
+		}
+		ctrlrtlog.FromContext(ctx).Info("deleted scheduled snapshot", "name", obj.GetName())
+	}
+	return nextExpiry, nil
+}
+
+// setTerminalCondition sets the Terminal condition of the SnapshotSchedule to
+// true with the message of the supplied error, or removes it if err is nil.
+func setTerminalCondition(schedule *svcapitypes.SnapshotSchedule, err error) {
+	conditions := []*ackv1alpha1.Condition{}
+	for _, c := range schedule.Status.Conditions {
+		if c.Type != ackv1alpha1.ConditionTypeTerminal {
+			conditions = append(conditions, c)
+		}
+	}
+	if err != nil {
+		message := err.Error()
+		now := metav1.Now()
+		conditions = append(conditions, &ackv1alpha1.Condition{
+			Type:               ackv1alpha1.ConditionTypeTerminal,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: &now,
+			Message:            &message,
+		})
+	}
+	schedule.Status.Conditions = conditions
+}
@@ -0,0 +1,237 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package schedule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlrtfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	ctrlrtreconcile "sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+)
+
+var testNow = time.Date(2024, time.January, 31, 10, 17, 0, 0, time.UTC)
+
+func newTestReconciler(t *testing.T, objs ...ctrlrtclient.Object) *snapshotScheduleReconciler {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := svcapitypes.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme() error = %v", err)
+	}
+	kc := ctrlrtfake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&svcapitypes.SnapshotSchedule{}).
+		Build()
+	return &snapshotScheduleReconciler{kc: kc, now: func() time.Time { return testNow }}
+}
+
+func newTestSchedule(spec svcapitypes.SnapshotScheduleSpec) *svcapitypes.SnapshotSchedule {
+	return &svcapitypes.SnapshotSchedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "nightly",
+			Namespace:         "default",
+			UID:               "8e1f04a7-5b2c-4d0e-9f3a-6c7d8e9f0a1b",
+			CreationTimestamp: metav1.NewTime(testNow.Add(-30 * 24 * time.Hour)),
+		},
+		Spec: spec,
+	}
+}
+
+func newTestSnapshot(schedule string, age time.Duration) *svcapitypes.Snapshot {
+	created := testNow.Add(-age)
+	return &svcapitypes.Snapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              schedule + "-" + created.Format(snapshotNameTimeFormat),
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(created),
+			Labels:            map[string]string{LabelSnapshotSchedule: schedule},
+		},
+	}
+}
+
+func listSnapshotNames(t *testing.T, kc ctrlrtclient.Client) map[string]bool {
+	t.Helper()
+	list := &svcapitypes.SnapshotList{}
+	if err := kc.List(context.Background(), list); err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	names := map[string]bool{}
+	for _, s := range list.Items {
+		names[s.Name] = true
+	}
+	return names
+}
+
+func Test_createSnapshot(t *testing.T) {
+	ref := &ackv1alpha1.AWSResourceReferenceWrapper{
+		From: &ackv1alpha1.AWSResourceReference{Name: aws.String("cache")},
+	}
+	tests := []struct {
+		name       string
+		spec       svcapitypes.SnapshotScheduleSpec
+		serverless bool
+		wantErr    bool
+	}{
+		{name: "replication group", spec: svcapitypes.SnapshotScheduleSpec{ReplicationGroupRef: ref}},
+		{name: "cache cluster", spec: svcapitypes.SnapshotScheduleSpec{CacheClusterRef: ref}},
+		{name: "serverless cache", spec: svcapitypes.SnapshotScheduleSpec{ServerlessCacheRef: ref}, serverless: true},
+		{name: "no target", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestReconciler(t)
+			schedule := newTestSchedule(tt.spec)
+			name, err := r.createSnapshot(context.Background(), schedule, testNow)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("createSnapshot() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if want := "nightly-8e1f04a7-20240131-1017"; name != want {
+				t.Errorf("createSnapshot() = %q, want %q", name, want)
+			}
+			key := types.NamespacedName{Namespace: "default", Name: name}
+			var obj ctrlrtclient.Object = &svcapitypes.Snapshot{}
+			if tt.serverless {
+				obj = &svcapitypes.ServerlessCacheSnapshot{}
+			}
+			if err := r.kc.Get(context.Background(), key, obj); err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			if got := obj.GetLabels()[LabelSnapshotSchedule]; got != "nightly" {
+				t.Errorf("snapshot label = %q, want %q", got, "nightly")
+			}
+			switch s := obj.(type) {
+			case *svcapitypes.Snapshot:
+				if aws.ToString(s.Spec.SnapshotName) != name {
+					t.Errorf("SnapshotName = %q, want %q", aws.ToString(s.Spec.SnapshotName), name)
+				}
+				if (s.Spec.ReplicationGroupRef != nil) != (tt.spec.ReplicationGroupRef != nil) ||
+					(s.Spec.CacheClusterRef != nil) != (tt.spec.CacheClusterRef != nil) {
+					t.Errorf("snapshot does not reference the target of the schedule")
+				}
+			case *svcapitypes.ServerlessCacheSnapshot:
+				if aws.ToString(s.Spec.ServerlessCacheSnapshotName) != name || s.Spec.ServerlessCacheRef == nil {
+					t.Errorf("serverless cache snapshot does not match the schedule")
+				}
+			}
+
+			// creating the same snapshot again, e.g. after a failed status
+			// update, succeeds
+			if _, err := r.createSnapshot(context.Background(), schedule, testNow); err != nil {
+				t.Errorf("createSnapshot() of an existing snapshot error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_pruneSnapshots(t *testing.T) {
+	hours := func(h int) time.Duration { return time.Duration(h) * time.Hour }
+	tests := []struct {
+		name           string
+		spec           svcapitypes.SnapshotScheduleSpec
+		wantKept       []time.Duration
+		wantNextExpiry time.Time
+	}{
+		{
+			name:     "no retention",
+			wantKept: []time.Duration{hours(1), hours(25), hours(49)},
+		},
+		{
+			name:     "retention count",
+			spec:     svcapitypes.SnapshotScheduleSpec{RetentionCount: aws.Int64(2)},
+			wantKept: []time.Duration{hours(1), hours(25)},
+		},
+		{
+			name:           "retention period",
+			spec:           svcapitypes.SnapshotScheduleSpec{RetentionPeriod: &metav1.Duration{Duration: hours(48)}},
+			wantKept:       []time.Duration{hours(1), hours(25)},
+			wantNextExpiry: testNow.Add(hours(23)),
+		},
+		{
+			name: "retention count and period",
+			spec: svcapitypes.SnapshotScheduleSpec{
+				RetentionCount:  aws.Int64(1),
+				RetentionPeriod: &metav1.Duration{Duration: hours(48)},
+			},
+			wantKept:       []time.Duration{hours(1)},
+			wantNextExpiry: testNow.Add(hours(47)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestReconciler(t,
+				newTestSnapshot("nightly", hours(1)),
+				newTestSnapshot("nightly", hours(25)),
+				newTestSnapshot("nightly", hours(49)),
+				// snapshots of other schedules are left alone
+				newTestSnapshot("weekly", hours(100)),
+			)
+			nextExpiry, err := r.pruneSnapshots(context.Background(), newTestSchedule(tt.spec), testNow)
+			if err != nil {
+				t.Fatalf("pruneSnapshots() error = %v", err)
+			}
+			if !nextExpiry.Equal(tt.wantNextExpiry) {
+				t.Errorf("pruneSnapshots() = %s, want %s", nextExpiry, tt.wantNextExpiry)
+			}
+			want := map[string]bool{newTestSnapshot("weekly", hours(100)).Name: true}
+			for _, age := range tt.wantKept {
+				want[newTestSnapshot("nightly", age).Name] = true
+			}
+			got := listSnapshotNames(t, r.kc)
+			if len(got) != len(want) {
+				t.Errorf("kept snapshots %v, want %v", got, want)
+			}
+			for name := range want {
+				if !got[name] {
+					t.Errorf("snapshot %s was deleted, want it kept", name)
+				}
+			}
+		})
+	}
+}
+
+func Test_Reconcile_RequeueAfter(t *testing.T) {
+	schedule := newTestSchedule(svcapitypes.SnapshotScheduleSpec{
+		Schedule:        aws.String("@weekly"),
+		RetentionPeriod: &metav1.Duration{Duration: 24 * time.Hour},
+		ReplicationGroupRef: &ackv1alpha1.AWSResourceReferenceWrapper{
+			From: &ackv1alpha1.AWSResourceReference{Name: aws.String("cache")},
+		},
+	})
+	schedule.Status.LastScheduleTime = &metav1.Time{Time: testNow.Add(-2 * time.Hour)}
+	r := newTestReconciler(t, schedule, newTestSnapshot("nightly", 2*time.Hour))
+
+	result, err := r.Reconcile(context.Background(), ctrlrtreconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: "nightly"},
+	})
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	// the snapshot expires well before the next weekly run
+	if want := 22 * time.Hour; result.RequeueAfter != want {
+		t.Errorf("Reconcile() RequeueAfter = %s, want %s", result.RequeueAfter, want)
+	}
+}
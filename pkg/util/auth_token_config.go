@@ -0,0 +1,75 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"time"
+
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	flagAuthTokenRotationGracePeriod = "auth-token-rotation-grace-period"
+
+	defaultAuthTokenRotationGracePeriod = time.Hour
+)
+
+// AuthTokenConfig configures how AUTH token rotations of replication groups
+// are finalized.
+type AuthTokenConfig struct {
+	// RotationGracePeriod is how long both the previous and the new AUTH
+	// token are accepted after a ROTATE, before the rotation is finalized
+	// with SET.
+	RotationGracePeriod time.Duration
+}
+
+// authTokenConfig is the AuthTokenConfig used by
+// AuthTokenRotationGracePeriod.
+var authTokenConfig = AuthTokenConfig{
+	RotationGracePeriod: defaultAuthTokenRotationGracePeriod,
+}
+
+// SetAuthTokenConfig stores the AuthTokenConfig used by
+// AuthTokenRotationGracePeriod.
+func SetAuthTokenConfig(cfg AuthTokenConfig) {
+	authTokenConfig = cfg
+}
+
+// AuthTokenRotationGracePeriod returns how long both the previous and the
+// new AUTH token are accepted after a ROTATE.
+func AuthTokenRotationGracePeriod() time.Duration {
+	return authTokenConfig.RotationGracePeriod
+}
+
+// BindFlags defines the command line flags of the AUTH token configuration.
+func (cfg *AuthTokenConfig) BindFlags() {
+	flag.DurationVar(
+		&cfg.RotationGracePeriod, flagAuthTokenRotationGracePeriod,
+		defaultAuthTokenRotationGracePeriod,
+		"How long both the previous and the new AUTH token of a replication group are accepted after a "+
+			"rotation, before the rotation is finalized and only the new AUTH token is accepted.",
+	)
+}
+
+// Validate returns an error if the AUTH token configuration is invalid.
+func (cfg AuthTokenConfig) Validate() error {
+	if cfg.RotationGracePeriod < 0 {
+		return fmt.Errorf(
+			"invalid value for flag '%s': grace period must not be negative",
+			flagAuthTokenRotationGracePeriod,
+		)
+	}
+	return nil
+}
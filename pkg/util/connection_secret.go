@@ -0,0 +1,250 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
+	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/elasticache-controller/pkg/common"
+)
+
+// connectionSecretFieldOwner is the field manager of the fields of the
+// connection Secrets applied by the controller.
+const connectionSecretFieldOwner = ctrlrtclient.FieldOwner("ack-elasticache-controller")
+
+// Keys of the connection Secret described by svcapitypes.ConnectionSecret.
+const (
+	ConnectionSecretKeyHost       = "host"
+	ConnectionSecretKeyPort       = "port"
+	ConnectionSecretKeyTLS        = "tls"
+	ConnectionSecretKeyReaderHost = "readerHost"
+	ConnectionSecretKeyReaderPort = "readerPort"
+	ConnectionSecretKeyAuthToken  = "authToken"
+)
+
+// AnnotationConnectionSecret is the annotation recording a hash of the
+// connection details last published into the connection Secret of a cache
+// resource. The Secret is published again when the hash differs from the
+// connection details of the latest observed resource.
+const AnnotationConnectionSecret = svcapitypes.AnnotationPrefix + "connection-secret"
+
+// ConnectionDetails are the values written to a connection Secret.
+type ConnectionDetails struct {
+	Endpoint       *svcapitypes.Endpoint
+	ReaderEndpoint *svcapitypes.Endpoint
+	TLS            bool
+	// AuthToken references the Secret holding the AUTH token published along
+	// with the endpoints.
+	AuthToken *ackv1alpha1.SecretKeyReference
+	// AuthTokenVersion changes along with the value of the AUTH token, so that
+	// a rotated token is published again.
+	AuthTokenVersion string
+}
+
+// data returns the contents of the connection Secret for the details and
+// the value of their AUTH token.
+func (d ConnectionDetails) data(authToken string) map[string][]byte {
+	data := map[string][]byte{
+		ConnectionSecretKeyTLS: []byte(strconv.FormatBool(d.TLS)),
+	}
+	if d.Endpoint != nil && d.Endpoint.Address != nil {
+		data[ConnectionSecretKeyHost] = []byte(*d.Endpoint.Address)
+		if d.Endpoint.Port != nil {
+			data[ConnectionSecretKeyPort] = []byte(strconv.FormatInt(*d.Endpoint.Port, 10))
+		}
+	}
+	if d.ReaderEndpoint != nil && d.ReaderEndpoint.Address != nil {
+		data[ConnectionSecretKeyReaderHost] = []byte(*d.ReaderEndpoint.Address)
+		if d.ReaderEndpoint.Port != nil {
+			data[ConnectionSecretKeyReaderPort] = []byte(strconv.FormatInt(*d.ReaderEndpoint.Port, 10))
+		}
+	}
+	if authToken != "" {
+		data[ConnectionSecretKeyAuthToken] = []byte(authToken)
+	}
+	return data
+}
+
+// hash returns a hash of the details published into the Secret named name.
+// The AUTH token is identified by its reference and version, so that the
+// hash is computed without reading the token.
+func (d ConnectionDetails) hash(name string) string {
+	data := d.data("")
+	h := sha256.New()
+	fmt.Fprintf(h, "name=%s\n", name)
+	for _, k := range slices.Sorted(maps.Keys(data)) {
+		fmt.Fprintf(h, "%s=%s\n", k, data[k])
+	}
+	if d.AuthToken != nil {
+		fmt.Fprintf(h, "%s=%s/%s/%s@%s\n", ConnectionSecretKeyAuthToken,
+			d.AuthToken.Namespace, d.AuthToken.Name, d.AuthToken.Key, d.AuthTokenVersion)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ConnectionSecretOutdated returns true if the connection Secret configured
+// by cfg was not yet published with the supplied details, according to the
+// annotations of owner. It is false until the cache has an endpoint.
+func ConnectionSecretOutdated(
+	owner metav1.Object,
+	cfg *svcapitypes.ConnectionSecret,
+	details ConnectionDetails,
+) bool {
+	if cfg == nil || cfg.Name == nil {
+		return false
+	}
+	if details.Endpoint == nil || details.Endpoint.Address == nil {
+		return false
+	}
+	return owner.GetAnnotations()[AnnotationConnectionSecret] != details.hash(*cfg.Name)
+}
+
+// SyncConnectionSecret publishes the connection details returned by details
+// into the connection Secret configured by cfg when delta differs at
+// Spec.ConnectionSecret, and removes that difference from delta. A Secret that
+// cannot be published requeues the resource. It returns true if delta has no
+// other difference.
+func SyncConnectionSecret(
+	ctx context.Context,
+	delta *ackcompare.Delta,
+	owner ctrlrtclient.Object,
+	cfg *svcapitypes.ConnectionSecret,
+	details func() ConnectionDetails,
+	secretValue func(context.Context, *ackv1alpha1.SecretKeyReference) (string, error),
+) (bool, error) {
+	if !delta.DifferentAt("Spec.ConnectionSecret") {
+		return false, nil
+	}
+	common.RemoveFromDelta(delta, "Spec.ConnectionSecret")
+	if err := PublishConnectionSecret(ctx, owner, cfg, details(), secretValue); err != nil {
+		return false, ackrequeue.Needed(fmt.Errorf("unable to publish connection Secret: %w", err))
+	}
+	return len(delta.Differences) == 0, nil
+}
+
+// PublishConnectionSecret creates or updates the connection Secret configured
+// by cfg, in the namespace of owner, with the supplied details, and records
+// their hash in the AnnotationConnectionSecret annotation of owner. The AUTH
+// token is read with secretValue. The Secret is owned by owner so that it is
+// deleted along with it, and an existing Secret that is not owned by owner is
+// never overwritten.
+//
+// The Secret is read with the uncached API reader and written with a
+// server-side apply, so that the controller neither caches every Secret of
+// the cluster nor needs to create or update Secrets.
+func PublishConnectionSecret(
+	ctx context.Context,
+	owner ctrlrtclient.Object,
+	cfg *svcapitypes.ConnectionSecret,
+	details ConnectionDetails,
+	secretValue func(context.Context, *ackv1alpha1.SecretKeyReference) (string, error),
+) error {
+	kc, reader := KubeClient(), APIReader()
+	if kc == nil || reader == nil || cfg == nil || cfg.Name == nil {
+		return nil
+	}
+	existing := &metav1.PartialObjectMetadata{}
+	existing.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+	err := reader.Get(ctx, types.NamespacedName{
+		Namespace: owner.GetNamespace(),
+		Name:      *cfg.Name,
+	}, existing)
+	switch {
+	case apierrors.IsNotFound(err):
+	case err != nil:
+		return err
+	case !metav1.IsControlledBy(existing, owner):
+		return fmt.Errorf("connection Secret %s/%s exists and is not owned by %s",
+			existing.Namespace, existing.Name, owner.GetName())
+	}
+
+	authToken := ""
+	if details.AuthToken != nil {
+		if authToken, err = secretValue(ctx, details.AuthToken); err != nil {
+			return fmt.Errorf("unable to read the AUTH token: %w", err)
+		}
+	}
+	gvk, err := apiutil.GVKForObject(owner, kc.Scheme())
+	if err != nil {
+		return err
+	}
+	secret := corev1ac.Secret(*cfg.Name, owner.GetNamespace()).
+		WithType(corev1.SecretTypeOpaque).
+		WithData(details.data(authToken)).
+		WithOwnerReferences(metav1ac.OwnerReference().
+			WithAPIVersion(gvk.GroupVersion().String()).
+			WithKind(gvk.Kind).
+			WithName(owner.GetName()).
+			WithUID(owner.GetUID()).
+			WithController(true).
+			WithBlockOwnerDeletion(true))
+	if err := kc.Apply(ctx, secret, connectionSecretFieldOwner, ctrlrtclient.ForceOwnership); err != nil {
+		return err
+	}
+	annotations := owner.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[AnnotationConnectionSecret] = details.hash(*cfg.Name)
+	owner.SetAnnotations(annotations)
+	return nil
+}
+
+// ForgetDeletedConnectionSecret removes the AnnotationConnectionSecret
+// annotation from owner when the connection Secret configured by cfg no
+// longer exists, so that the deleted Secret is published again. The Secret is
+// assumed to exist when it cannot be read.
+func ForgetDeletedConnectionSecret(
+	ctx context.Context,
+	owner ctrlrtclient.Object,
+	cfg *svcapitypes.ConnectionSecret,
+) {
+	reader := APIReader()
+	if reader == nil || cfg == nil || cfg.Name == nil {
+		return
+	}
+	annotations := owner.GetAnnotations()
+	if _, ok := annotations[AnnotationConnectionSecret]; !ok {
+		return
+	}
+	secret := &metav1.PartialObjectMetadata{}
+	secret.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+	err := reader.Get(ctx, types.NamespacedName{
+		Namespace: owner.GetNamespace(),
+		Name:      *cfg.Name,
+	}, secret)
+	if apierrors.IsNotFound(err) {
+		delete(annotations, AnnotationConnectionSecret)
+		owner.SetAnnotations(annotations)
+	}
+}
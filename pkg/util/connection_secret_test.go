@@ -0,0 +1,127 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrlrtfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+)
+
+func Test_ConnectionSecretOutdated(t *testing.T) {
+	cfg := &svcapitypes.ConnectionSecret{Name: aws.String("conn")}
+	details := ConnectionDetails{
+		Endpoint: &svcapitypes.Endpoint{Address: aws.String("cache.example.com"), Port: aws.Int64(6379)},
+		TLS:      true,
+		AuthToken: &ackv1alpha1.SecretKeyReference{
+			SecretReference: corev1.SecretReference{Name: "token"},
+			Key:             "token",
+		},
+		AuthTokenVersion: "v1",
+	}
+	published := &metav1.ObjectMeta{Annotations: map[string]string{
+		AnnotationConnectionSecret: details.hash(*cfg.Name),
+	}}
+
+	if ConnectionSecretOutdated(&metav1.ObjectMeta{}, nil, details) {
+		t.Error("ConnectionSecretOutdated() = true without a connection Secret")
+	}
+	if ConnectionSecretOutdated(&metav1.ObjectMeta{}, cfg, ConnectionDetails{TLS: true}) {
+		t.Error("ConnectionSecretOutdated() = true without an endpoint")
+	}
+	if !ConnectionSecretOutdated(&metav1.ObjectMeta{}, cfg, details) {
+		t.Error("ConnectionSecretOutdated() = false before the Secret was published")
+	}
+	if ConnectionSecretOutdated(published, cfg, details) {
+		t.Error("ConnectionSecretOutdated() = true after the Secret was published")
+	}
+
+	moved := details
+	moved.Endpoint = &svcapitypes.Endpoint{Address: aws.String("replica.example.com"), Port: aws.Int64(6379)}
+	if !ConnectionSecretOutdated(published, cfg, moved) {
+		t.Error("ConnectionSecretOutdated() = false after the endpoint changed")
+	}
+	rotated := details
+	rotated.AuthTokenVersion = "v2"
+	if !ConnectionSecretOutdated(published, cfg, rotated) {
+		t.Error("ConnectionSecretOutdated() = false after the AUTH token changed")
+	}
+	if !ConnectionSecretOutdated(published, &svcapitypes.ConnectionSecret{Name: aws.String("renamed")}, details) {
+		t.Error("ConnectionSecretOutdated() = false after the Secret was renamed")
+	}
+}
+
+func Test_PublishConnectionSecret(t *testing.T) {
+	ctx := context.Background()
+	owner := &svcapitypes.ServerlessCache{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cache", UID: "uid"},
+	}
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = svcapitypes.AddToScheme(scheme)
+	kc := ctrlrtfake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foreign"}},
+	).Build()
+	SetKubeClient(kc)
+	SetAPIReader(kc)
+	defer SetKubeClient(nil)
+	defer SetAPIReader(nil)
+
+	cfg := &svcapitypes.ConnectionSecret{Name: aws.String("conn")}
+	details := ConnectionDetails{
+		Endpoint: &svcapitypes.Endpoint{Address: aws.String("cache.example.com"), Port: aws.Int64(6379)},
+	}
+	if err := PublishConnectionSecret(ctx, owner, cfg, details, nil); err != nil {
+		t.Fatalf("PublishConnectionSecret() error = %v", err)
+	}
+	secret := &corev1.Secret{}
+	if err := kc.Get(ctx, types.NamespacedName{Namespace: "default", Name: "conn"}, secret); err != nil {
+		t.Fatal(err)
+	}
+	if got := string(secret.Data[ConnectionSecretKeyHost]); got != "cache.example.com" {
+		t.Errorf("host = %q, want %q", got, "cache.example.com")
+	}
+	if !metav1.IsControlledBy(secret, owner) {
+		t.Error("connection Secret is not controlled by its owner")
+	}
+	if ConnectionSecretOutdated(owner, cfg, details) {
+		t.Error("ConnectionSecretOutdated() = true after the Secret was published")
+	}
+
+	ForgetDeletedConnectionSecret(ctx, owner, cfg)
+	if ConnectionSecretOutdated(owner, cfg, details) {
+		t.Error("ConnectionSecretOutdated() = true while the Secret exists")
+	}
+	if err := kc.Delete(ctx, secret); err != nil {
+		t.Fatal(err)
+	}
+	ForgetDeletedConnectionSecret(ctx, owner, cfg)
+	if !ConnectionSecretOutdated(owner, cfg, details) {
+		t.Error("ConnectionSecretOutdated() = false after the Secret was deleted")
+	}
+
+	if err := PublishConnectionSecret(ctx, owner, &svcapitypes.ConnectionSecret{Name: aws.String("foreign")}, details, nil); err == nil {
+		t.Error("PublishConnectionSecret() error = nil for a Secret that is not owned by the resource")
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strconv"
+
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+)
+
+// AnnotationDeletionProtection is an annotation whose value is a boolean
+// value. While it is present and not "false", the controller refuses to delete
+// the AWS resource backing the custom resource.
+const AnnotationDeletionProtection = svcapitypes.AnnotationPrefix + "deletion-protection"
+
+var condMsgDeletionProtected = fmt.Sprintf(
+	"deletion is blocked by the %s annotation, remove it to delete the resource",
+	AnnotationDeletionProtection,
+)
+
+var requeueWaitWhileDeletionProtected = ackrequeue.NeededAfter(
+	fmt.Errorf("deletion is blocked by the %s annotation", AnnotationDeletionProtection),
+	ackrequeue.DefaultRequeueAfterDuration,
+)
+
+// IsDeletionProtected returns true if the supplied resource carries the
+// deletion protection annotation. A value that cannot be parsed as a boolean
+// protects the resource, so that a typo never leads to a deletion.
+func IsDeletionProtected(res acktypes.AWSResource) bool {
+	value, ok := res.MetaObject().GetAnnotations()[AnnotationDeletionProtection]
+	if !ok {
+		return false
+	}
+	protected, err := strconv.ParseBool(value)
+	return err != nil || protected
+}
+
+// RequeueIfDeletionProtected is called by the delete hooks before the AWS
+// delete API is called. If the supplied resource is deletion protected, it
+// sets the resource synced condition to false with a message explaining why
+// and returns a requeue error, so that the deletion is retried once the
+// annotation is removed.
+func RequeueIfDeletionProtected(res acktypes.AWSResource) error {
+	if !IsDeletionProtected(res) {
+		return nil
+	}
+	ackcondition.SetSynced(
+		res,
+		corev1.ConditionFalse,
+		&condMsgDeletionProtected,
+		nil,
+	)
+	return requeueWaitWhileDeletionProtected
+}
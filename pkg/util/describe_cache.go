@@ -0,0 +1,386 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	flag "github.com/spf13/pflag"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	flagDescribeCacheTTL = "describe-cache-ttl"
+
+	describeCacheKindReplicationGroup = "ReplicationGroup"
+	describeCacheKindCacheCluster     = "CacheCluster"
+	describeCacheKindServerlessCache  = "ServerlessCache"
+)
+
+// DescribeCacheConfig configures the describe cache, which lists all
+// replication groups, cache clusters and serverless caches of an account and
+// region at most once per TTL so that reads of steady-state resources do not
+// need their own Describe calls.
+type DescribeCacheConfig struct {
+	// TTL is how long listed resources are served from the describe cache.
+	// When relisting fails, they are served for up to twice the TTL. Zero
+	// disables the cache.
+	TTL time.Duration
+}
+
+// describeCacheConfig is the DescribeCacheConfig used by the Cached
+// functions.
+var describeCacheConfig DescribeCacheConfig
+
+// SetDescribeCacheConfig stores the DescribeCacheConfig used by the Cached
+// functions.
+func SetDescribeCacheConfig(cfg DescribeCacheConfig) {
+	describeCacheConfig = cfg
+}
+
+// BindFlags defines the command line flags of the describe cache
+// configuration.
+func (cfg *DescribeCacheConfig) BindFlags() {
+	flag.DurationVar(
+		&cfg.TTL, flagDescribeCacheTTL,
+		0,
+		"How long replication groups, cache clusters and serverless caches listed for an account and region "+
+			"are used to read steady-state resources. Set to 0 to describe each resource separately.",
+	)
+}
+
+// Validate returns an error if the describe cache configuration is invalid.
+func (cfg DescribeCacheConfig) Validate() error {
+	if cfg.TTL < 0 {
+		return fmt.Errorf("invalid value for flag '%s': ttl must not be negative", flagDescribeCacheTTL)
+	}
+	return nil
+}
+
+// describeCacheListTimeout bounds a listing. A listing is shared by all the
+// resources reading from the describe cache, so it does not use the context
+// of the resource that started it.
+const describeCacheListTimeout = 2 * time.Minute
+
+// describeCacheMaxAgeTTLs is how many TTLs the resources of a listing are
+// served for at most. Past that, relisting failed for at least a TTL and the
+// resources are described separately until a listing succeeds again.
+const describeCacheMaxAgeTTLs = 2
+
+// describeCache keeps the resources of a kind listed for an account and
+// region, keyed by identifier. Listings run outside of mu and replace the
+// kept resources once they succeed, so the resources of the previous listing
+// are served while a listing is in progress or after it failed, for at most
+// describeCacheMaxAgeTTLs TTLs.
+type describeCache[T any] struct {
+	lists singleflight.Group
+
+	mu sync.Mutex
+	// listedAt is when the last successful listing started.
+	listedAt time.Time
+	// nextList is when the next listing is due, after both successful and
+	// failed listings.
+	nextList time.Time
+	// items are the listed resources. The map is not modified once stored.
+	items map[string]T
+	// invalidated are the times resources were last changed by the
+	// controller. A resource is not served from a listing that started
+	// before then.
+	invalidated map[string]time.Time
+}
+
+// invalidator is implemented by the describe caches of all kinds.
+type invalidator interface {
+	invalidate(identifier string, at time.Time)
+}
+
+var (
+	describeCachesMu sync.Mutex
+	// describeCaches are the describe caches, keyed by kind, account and
+	// region.
+	describeCaches = map[string]invalidator{}
+)
+
+// getDescribeCache returns the describe cache of the kind, account and
+// region.
+func getDescribeCache[T any](
+	kind string,
+	accountID ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+) *describeCache[T] {
+	key := describeCacheKey(kind, accountID, region)
+	describeCachesMu.Lock()
+	defer describeCachesMu.Unlock()
+	c, ok := describeCaches[key].(*describeCache[T])
+	if !ok {
+		c = &describeCache[T]{
+			items:       map[string]T{},
+			invalidated: map[string]time.Time{},
+		}
+		describeCaches[key] = c
+	}
+	return c
+}
+
+func describeCacheKey(
+	kind string,
+	accountID ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+) string {
+	return kind + "/" + string(accountID) + "/" + string(region)
+}
+
+// get returns the resource with the identifier, listing all resources if the
+// TTL has elapsed. Only the first listing is waited for; later listings run in
+// the background while the resources of the previous listing are served. It
+// returns false if the resource was not listed, was changed by the controller
+// since it was listed, or was listed too long ago because later listings
+// failed.
+func (c *describeCache[T]) get(
+	ctx context.Context,
+	identifier string,
+	list func(context.Context) (map[string]T, error),
+) (T, bool, error) {
+	var item T
+	c.mu.Lock()
+	due := !time.Now().Before(c.nextList)
+	listed := !c.listedAt.IsZero()
+	c.mu.Unlock()
+	if due {
+		listing := c.lists.DoChan("", func() (interface{}, error) {
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), describeCacheListTimeout)
+			defer cancel()
+			return nil, c.list(ctx, list)
+		})
+		if !listed {
+			if res := <-listing; res.Err != nil {
+				return item, false, res.Err
+			}
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if time.Since(c.listedAt) > describeCacheMaxAgeTTLs*describeCacheConfig.TTL {
+		return item, false, nil
+	}
+	if _, ok := c.invalidated[identifier]; ok {
+		return item, false, nil
+	}
+	item, ok := c.items[identifier]
+	return item, ok, nil
+}
+
+// list lists all resources and replaces the kept resources with them. The
+// kept resources are left alone if the listing fails.
+func (c *describeCache[T]) list(
+	ctx context.Context,
+	list func(context.Context) (map[string]T, error),
+) error {
+	now := time.Now()
+	c.mu.Lock()
+	due := !now.Before(c.nextList)
+	c.mu.Unlock()
+	if !due {
+		// Another listing completed since the listing was found due.
+		return nil
+	}
+
+	items, err := list(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nextList = now.Add(describeCacheConfig.TTL)
+	if err != nil {
+		return err
+	}
+	c.listedAt = now
+	c.items = items
+	for id, at := range c.invalidated {
+		if c.listedAt.After(at) {
+			delete(c.invalidated, id)
+		}
+	}
+	return nil
+}
+
+func (c *describeCache[T]) invalidate(identifier string, at time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidated[identifier] = at
+}
+
+// InvalidateDescribeCache stops the resource with the identifier from being
+// served from the describe caches of the account and region until they are
+// listed again. It is called when the controller changes the resource.
+func InvalidateDescribeCache(
+	accountID ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+	identifier *string,
+) {
+	if describeCacheConfig.TTL == 0 || identifier == nil {
+		return
+	}
+	now := time.Now()
+	describeCachesMu.Lock()
+	defer describeCachesMu.Unlock()
+	for _, kind := range []string{
+		describeCacheKindReplicationGroup,
+		describeCacheKindCacheCluster,
+		describeCacheKindServerlessCache,
+	} {
+		if c, ok := describeCaches[describeCacheKey(kind, accountID, region)]; ok {
+			c.invalidate(*identifier, now)
+		}
+	}
+}
+
+// CachedReplicationGroup returns the replication group with the identifier
+// from the describe cache of the account and region. It returns false if the
+// describe cache is disabled or cannot serve the replication group.
+func CachedReplicationGroup(
+	ctx context.Context,
+	sdkapi *svcsdk.Client,
+	metrics *metrics.Metrics,
+	accountID ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+	replicationGroupID *string,
+) (*svcsdktypes.ReplicationGroup, bool, error) {
+	if describeCacheConfig.TTL == 0 || replicationGroupID == nil {
+		return nil, false, nil
+	}
+	c := getDescribeCache[svcsdktypes.ReplicationGroup](describeCacheKindReplicationGroup, accountID, region)
+	rg, ok, err := c.get(ctx, *replicationGroupID, func(ctx context.Context) (map[string]svcsdktypes.ReplicationGroup, error) {
+		items := map[string]svcsdktypes.ReplicationGroup{}
+		input := &svcsdk.DescribeReplicationGroupsInput{
+			MaxRecords: aws.Int32(100),
+		}
+		for {
+			resp, err := sdkapi.DescribeReplicationGroups(ctx, input)
+			metrics.RecordAPICall("READ_MANY", "DescribeReplicationGroups", err)
+			if err != nil {
+				return nil, err
+			}
+			for _, rg := range resp.ReplicationGroups {
+				if rg.ReplicationGroupId != nil {
+					items[*rg.ReplicationGroupId] = rg
+				}
+			}
+			if resp.Marker == nil || *resp.Marker == "" {
+				return items, nil
+			}
+			input.Marker = resp.Marker
+		}
+	})
+	if !ok || err != nil {
+		return nil, false, err
+	}
+	return &rg, true, nil
+}
+
+// CachedCacheCluster returns the cache cluster with the identifier, including
+// its cache node info, from the describe cache of the account and region. It
+// returns false if the describe cache is disabled or cannot serve the cache
+// cluster.
+func CachedCacheCluster(
+	ctx context.Context,
+	sdkapi *svcsdk.Client,
+	metrics *metrics.Metrics,
+	accountID ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+	cacheClusterID *string,
+) (*svcsdktypes.CacheCluster, bool, error) {
+	if describeCacheConfig.TTL == 0 || cacheClusterID == nil {
+		return nil, false, nil
+	}
+	c := getDescribeCache[svcsdktypes.CacheCluster](describeCacheKindCacheCluster, accountID, region)
+	cc, ok, err := c.get(ctx, *cacheClusterID, func(ctx context.Context) (map[string]svcsdktypes.CacheCluster, error) {
+		items := map[string]svcsdktypes.CacheCluster{}
+		input := &svcsdk.DescribeCacheClustersInput{
+			MaxRecords:        aws.Int32(100),
+			ShowCacheNodeInfo: aws.Bool(true),
+		}
+		for {
+			resp, err := sdkapi.DescribeCacheClusters(ctx, input)
+			metrics.RecordAPICall("READ_MANY", "DescribeCacheClusters", err)
+			if err != nil {
+				return nil, err
+			}
+			for _, cc := range resp.CacheClusters {
+				if cc.CacheClusterId != nil {
+					items[*cc.CacheClusterId] = cc
+				}
+			}
+			if resp.Marker == nil || *resp.Marker == "" {
+				return items, nil
+			}
+			input.Marker = resp.Marker
+		}
+	})
+	if !ok || err != nil {
+		return nil, false, err
+	}
+	return &cc, true, nil
+}
+
+// CachedServerlessCache returns the serverless cache with the name from the
+// describe cache of the account and region. It returns false if the describe
+// cache is disabled or cannot serve the serverless cache.
+func CachedServerlessCache(
+	ctx context.Context,
+	sdkapi *svcsdk.Client,
+	metrics *metrics.Metrics,
+	accountID ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+	serverlessCacheName *string,
+) (*svcsdktypes.ServerlessCache, bool, error) {
+	if describeCacheConfig.TTL == 0 || serverlessCacheName == nil {
+		return nil, false, nil
+	}
+	c := getDescribeCache[svcsdktypes.ServerlessCache](describeCacheKindServerlessCache, accountID, region)
+	sc, ok, err := c.get(ctx, *serverlessCacheName, func(ctx context.Context) (map[string]svcsdktypes.ServerlessCache, error) {
+		items := map[string]svcsdktypes.ServerlessCache{}
+		input := &svcsdk.DescribeServerlessCachesInput{
+			MaxResults: aws.Int32(50),
+		}
+		for {
+			resp, err := sdkapi.DescribeServerlessCaches(ctx, input)
+			metrics.RecordAPICall("READ_MANY", "DescribeServerlessCaches", err)
+			if err != nil {
+				return nil, err
+			}
+			for _, sc := range resp.ServerlessCaches {
+				if sc.ServerlessCacheName != nil {
+					items[*sc.ServerlessCacheName] = sc
+				}
+			}
+			if resp.NextToken == nil || *resp.NextToken == "" {
+				return items, nil
+			}
+			input.NextToken = resp.NextToken
+		}
+	})
+	if !ok || err != nil {
+		return nil, false, err
+	}
+	return &sc, true, nil
+}
@@ -0,0 +1,158 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// waitForListing waits for the listing of the describe cache in progress, if
+// any.
+func waitForListing[T any](c *describeCache[T]) {
+	c.lists.Do("", func() (interface{}, error) { return nil, nil })
+}
+
+func Test_describeCache(t *testing.T) {
+	SetDescribeCacheConfig(DescribeCacheConfig{TTL: time.Hour})
+	defer SetDescribeCacheConfig(DescribeCacheConfig{})
+
+	listed := map[string]int{"a": 1, "b": 1}
+	var listErr error
+	lists := 0
+	list := func(ctx context.Context) (map[string]int, error) {
+		lists++
+		if listErr != nil {
+			return nil, listErr
+		}
+		items := map[string]int{}
+		for id, v := range listed {
+			items[id] = v
+		}
+		return items, nil
+	}
+	c := &describeCache[int]{items: map[string]int{}, invalidated: map[string]time.Time{}}
+
+	if v, ok, err := c.get(context.Background(), "a", list); err != nil || !ok || v != 1 {
+		t.Fatalf("get(a) = %d, %t, %v, want 1, true, nil", v, ok, err)
+	}
+	if _, ok, _ := c.get(context.Background(), "c", list); ok {
+		t.Errorf("get(c) = true for a resource that was not listed")
+	}
+	if lists != 1 {
+		t.Errorf("listed %d times within the TTL, want 1", lists)
+	}
+
+	// an invalidated resource is not served until it is listed again
+	c.invalidate("a", time.Now())
+	if _, ok, _ := c.get(context.Background(), "a", list); ok {
+		t.Errorf("get(a) = true after it was invalidated")
+	}
+	if _, ok, _ := c.get(context.Background(), "b", list); !ok {
+		t.Errorf("get(b) = false after another resource was invalidated")
+	}
+
+	// once the TTL has elapsed, the previous listing is served while the
+	// next listing runs
+	listed["a"] = 2
+	c.nextList = time.Now().Add(-time.Second)
+	if v, _, _ := c.get(context.Background(), "b", list); v != 1 {
+		t.Errorf("get(b) = %d while listing, want the previously listed 1", v)
+	}
+	waitForListing(c)
+	if lists != 2 {
+		t.Errorf("listed %d times after the TTL elapsed, want 2", lists)
+	}
+	if v, ok, _ := c.get(context.Background(), "a", list); !ok || v != 2 {
+		t.Errorf("get(a) = %d, %t after it was listed again, want 2, true", v, ok)
+	}
+
+	// a failed listing keeps the previous listing
+	listErr = errors.New("boom")
+	c.nextList = time.Now().Add(-time.Second)
+	c.get(context.Background(), "a", list)
+	waitForListing(c)
+	if v, ok, err := c.get(context.Background(), "a", list); err != nil || !ok || v != 2 {
+		t.Errorf("get(a) = %d, %t, %v after a failed listing, want 2, true, nil", v, ok, err)
+	}
+	if lists != 3 {
+		t.Errorf("listed %d times after a failed listing, want 3", lists)
+	}
+
+	// a listing that is cancelled by the caller is not cancelled for others
+	listErr = nil
+	listed["a"] = 3
+	c.nextList = time.Now().Add(-time.Second)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	c.get(ctx, "a", func(ctx context.Context) (map[string]int, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return list(ctx)
+	})
+	waitForListing(c)
+	if v, _, _ := c.get(context.Background(), "a", list); v != 3 {
+		t.Errorf("get(a) = %d after a listing started by a cancelled caller, want 3", v)
+	}
+}
+
+func Test_describeCache_firstListingFails(t *testing.T) {
+	SetDescribeCacheConfig(DescribeCacheConfig{TTL: time.Hour})
+	defer SetDescribeCacheConfig(DescribeCacheConfig{})
+
+	c := &describeCache[int]{items: map[string]int{}, invalidated: map[string]time.Time{}}
+	_, ok, err := c.get(context.Background(), "a", func(ctx context.Context) (map[string]int, error) {
+		return nil, errors.New("boom")
+	})
+	if ok || err == nil {
+		t.Errorf("get(a) = %t, %v when the first listing fails, want false and an error", ok, err)
+	}
+	// resources are described directly until the next listing is due
+	if _, ok, err := c.get(context.Background(), "a", nil); ok || err != nil {
+		t.Errorf("get(a) = %t, %v after the first listing failed, want false, nil", ok, err)
+	}
+}
+
+func Test_describeCache_relistingFails(t *testing.T) {
+	SetDescribeCacheConfig(DescribeCacheConfig{TTL: time.Hour})
+	defer SetDescribeCacheConfig(DescribeCacheConfig{})
+
+	c := &describeCache[int]{items: map[string]int{}, invalidated: map[string]time.Time{}}
+	if _, ok, err := c.get(context.Background(), "a", func(ctx context.Context) (map[string]int, error) {
+		return map[string]int{"a": 1}, nil
+	}); !ok || err != nil {
+		t.Fatalf("get(a) = %t, %v, want true, nil", ok, err)
+	}
+
+	failing := func(ctx context.Context) (map[string]int, error) {
+		return nil, errors.New("boom")
+	}
+	// the listing is served for a while after relisting failed
+	c.listedAt = time.Now().Add(-90 * time.Minute)
+	c.nextList = time.Now().Add(-time.Second)
+	c.get(context.Background(), "a", failing)
+	waitForListing(c)
+	if v, ok, err := c.get(context.Background(), "a", failing); !ok || err != nil || v != 1 {
+		t.Errorf("get(a) = %d, %t, %v after a failed relisting, want 1, true, nil", v, ok, err)
+	}
+
+	// but not past the maximum age, even though the next listing is not due
+	c.listedAt = time.Now().Add(-3 * time.Hour)
+	if _, ok, err := c.get(context.Background(), "a", failing); ok || err != nil {
+		t.Errorf("get(a) = %t, %v past the maximum age, want false, nil", ok, err)
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"golang.org/x/sync/singleflight"
+
+	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+)
+
+// eventPollOverlap is how far before the previous poll a poll starts, so
+// that events dated shortly before they became visible are not missed.
+const eventPollOverlap = time.Minute
+
+// eventPollTimeout bounds a poll. A poll is shared by all the resources
+// waiting for it, so it does not use the context of the resource that
+// started it.
+const eventPollTimeout = 2 * time.Minute
+
+// eventSource identifies the source of ElastiCache events.
+type eventSource struct {
+	sourceType svcsdktypes.SourceType
+	identifier string
+}
+
+// eventPoller describes the events of all sources of an account and region
+// at most once per poll interval, and keeps the latest events of each source
+// for the resources that report them. Polls run outside of mu and replace the
+// kept events once they complete, so reading the events of a source never
+// waits for DescribeEvents unless a poll is due.
+type eventPoller struct {
+	polls singleflight.Group
+
+	mu sync.Mutex
+	// lastPoll is when the last successful poll started.
+	lastPoll time.Time
+	// nextPoll is when the next poll is due, after both successful and
+	// failed polls.
+	nextPoll time.Time
+	// err is the error of the last poll, or nil if it succeeded.
+	err error
+	// events are the latest events of each source, newest first. The map
+	// and its slices are not modified once stored.
+	events map[eventSource][]*svcapitypes.Event
+}
+
+var (
+	eventPollersMu sync.Mutex
+	// eventPollers are the shared event pollers, keyed by account and region.
+	eventPollers = map[string]*eventPoller{}
+)
+
+// getEventPoller returns the shared event poller of the account and region.
+func getEventPoller(
+	accountID ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+) *eventPoller {
+	key := string(accountID) + "/" + string(region)
+	eventPollersMu.Lock()
+	defer eventPollersMu.Unlock()
+	p, ok := eventPollers[key]
+	if !ok {
+		p = &eventPoller{events: map[eventSource][]*svcapitypes.Event{}}
+		eventPollers[key] = p
+	}
+	return p
+}
+
+// sourceEvents returns the latest events of the source, newest first,
+// polling the events of all sources first if a poll is due. If the poll
+// fails, the events kept from the previous poll are returned, and the error
+// is only returned if no poll has succeeded yet.
+func (p *eventPoller) sourceEvents(
+	ctx context.Context,
+	sdkapi *svcsdk.Client,
+	metrics *metrics.Metrics,
+	sourceType svcsdktypes.SourceType,
+	sourceIdentifier string,
+) ([]*svcapitypes.Event, error) {
+	if p.pollDue(time.Now()) {
+		p.polls.Do("", func() (interface{}, error) {
+			// Another poll may have completed since pollDue was checked.
+			if now := time.Now(); p.pollDue(now) {
+				ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), eventPollTimeout)
+				defer cancel()
+				p.poll(ctx, sdkapi, metrics, now)
+			}
+			return nil, nil
+		})
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.lastPoll.IsZero() && p.err != nil {
+		return nil, p.err
+	}
+	events := p.events[eventSource{sourceType, sourceIdentifier}]
+	return append([]*svcapitypes.Event{}, events...), nil
+}
+
+// pollDue returns true if the events of all sources are to be polled at now.
+func (p *eventPoller) pollDue(now time.Time) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return !now.Before(p.nextPoll)
+}
+
+// poll describes the events of all sources since the previous poll, or
+// within the configured window on the first poll, and merges them into the
+// kept events. The kept events are left alone if the poll fails.
+func (p *eventPoller) poll(
+	ctx context.Context,
+	sdkapi *svcsdk.Client,
+	metrics *metrics.Metrics,
+	now time.Time,
+) {
+	p.mu.Lock()
+	lastPoll, kept := p.lastPoll, p.events
+	p.mu.Unlock()
+
+	input := &svcsdk.DescribeEventsInput{}
+	input.MaxRecords = aws.Int32(maxDescribeEventsRecords)
+	if lastPoll.IsZero() {
+		input.Duration = aws.Int32(eventsConfig.durationMinutes())
+	} else {
+		input.StartTime = aws.Time(lastPoll.Add(-eventPollOverlap))
+	}
+
+	polled := map[eventSource][]*svcapitypes.Event{}
+	var err error
+	for {
+		var resp *svcsdk.DescribeEventsOutput
+		resp, err = sdkapi.DescribeEvents(ctx, input)
+		metrics.RecordAPICall("READ_MANY", "DescribeEvents", err)
+		if err != nil {
+			break
+		}
+		for _, e := range resp.Events {
+			if e.SourceIdentifier == nil {
+				continue
+			}
+			source := eventSource{e.SourceType, *e.SourceIdentifier}
+			polled[source] = append(polled[source], newEvent(e))
+		}
+		if resp.Marker == nil || *resp.Marker == "" {
+			break
+		}
+		input.Marker = resp.Marker
+	}
+	if err == nil {
+		kept = mergeEvents(kept, polled, now.Add(-eventsConfig.Window))
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.nextPoll = now.Add(eventsConfig.PollInterval)
+	p.err = err
+	if err == nil {
+		p.lastPoll = now
+		p.events = kept
+	}
+}
+
+// mergeEvents returns the kept events of each source with the polled events
+// added, dropping duplicates, events dated before cutoff and all but the
+// latest EventsConfig.MaxRecords events. The supplied maps are not modified.
+func mergeEvents(
+	kept map[eventSource][]*svcapitypes.Event,
+	polled map[eventSource][]*svcapitypes.Event,
+	cutoff time.Time,
+) map[eventSource][]*svcapitypes.Event {
+	all := map[eventSource][]*svcapitypes.Event{}
+	for source, events := range kept {
+		all[source] = append(all[source], events...)
+	}
+	for source, events := range polled {
+		all[source] = append(all[source], events...)
+	}
+	merged := map[eventSource][]*svcapitypes.Event{}
+	for source, events := range all {
+		unique := []*svcapitypes.Event{}
+		seen := map[string]bool{}
+		for _, e := range events {
+			if e.Date == nil || e.Date.Time.Before(cutoff) {
+				continue
+			}
+			key := e.Date.Time.Format(time.RFC3339Nano) + "/" + aws.ToString(e.Message)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			unique = append(unique, e)
+		}
+		if len(unique) == 0 {
+			continue
+		}
+		sort.SliceStable(unique, func(i, j int) bool {
+			return unique[i].Date.Time.After(unique[j].Date.Time)
+		})
+		if len(unique) > eventsConfig.MaxRecords {
+			unique = unique[:eventsConfig.MaxRecords]
+		}
+		merged[source] = unique
+	}
+	return merged
+}
@@ -0,0 +1,259 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+
+const (
+	// EventReasonElastiCache is the reason of the Kubernetes Events emitted
+	// for ElastiCache events.
+	EventReasonElastiCache = "ElastiCacheEvent"
+
+	eventActionDescribeEvents = "DescribeEvents"
+)
+
+// eventRecorder emits Kubernetes Events for ElastiCache events. Like the
+// Kubernetes client, it is stored here once the controller manager has been
+// created.
+var eventRecorder events.EventRecorder
+
+// SetEventRecorder stores the recorder used by RecordEvents.
+func SetEventRecorder(r events.EventRecorder) {
+	eventRecorder = r
+}
+
+// emittedEvents are the newest ElastiCache events emitted as Kubernetes Events
+// for a resource.
+type emittedEvents struct {
+	// time is the date of the newest emitted events. Events dated before it
+	// are not emitted again.
+	time time.Time
+	// hashes are the hashes of the messages of the emitted events dated at
+	// time. ElastiCache event dates only have a resolution of one second, so
+	// an event dated at time may only be described after the events emitted
+	// before it.
+	hashes map[string]bool
+}
+
+var (
+	emittedEventsMu sync.Mutex
+	// lastEmittedEventsByUID are the newest events emitted for each resource,
+	// keyed by UID. They are kept in memory, so that recording them does not
+	// write to the resource while it is being read.
+	lastEmittedEventsByUID = map[types.UID]emittedEvents{}
+	// emittingSince is when the controller started emitting events. Events
+	// dated before it are not emitted, so that a restarted controller does
+	// not emit the events of the window again.
+	emittingSince = time.Now()
+)
+
+// eventSourceKinds maps an event source type to the resource kind used in
+// the DescribeEvents metric name.
+var eventSourceKinds = map[svcsdktypes.SourceType]string{
+	svcsdktypes.SourceTypeCacheCluster:        "CacheCluster",
+	svcsdktypes.SourceTypeCacheParameterGroup: "CacheParameterGroup",
+	svcsdktypes.SourceTypeCacheSubnetGroup:    "CacheSubnetGroup",
+	svcsdktypes.SourceTypeReplicationGroup:    "ReplicationGroup",
+	svcsdktypes.SourceTypeServerlessCache:     "ServerlessCache",
+	svcsdktypes.SourceTypeUser:                "User",
+}
+
+// DescribeEvents returns the latest events of the supplied source, newest
+// first, within the window and up to the record count of the EventsConfig.
+// When the shared event poller is enabled, the events are taken from the
+// poller of the account and region instead of being described for the
+// source alone.
+func DescribeEvents(
+	ctx context.Context,
+	sdkapi *svcsdk.Client,
+	metrics *metrics.Metrics,
+	accountID ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+	sourceType svcsdktypes.SourceType,
+	sourceIdentifier *string,
+) ([]*svcapitypes.Event, error) {
+	if sourceIdentifier == nil {
+		return []*svcapitypes.Event{}, nil
+	}
+	if eventsConfig.PollInterval > 0 {
+		return getEventPoller(accountID, region).sourceEvents(
+			ctx, sdkapi, metrics, sourceType, *sourceIdentifier,
+		)
+	}
+
+	input := &svcsdk.DescribeEventsInput{}
+	input.SourceType = sourceType
+	input.SourceIdentifier = sourceIdentifier
+	input.MaxRecords = aws.Int32(int32(max(eventsConfig.MaxRecords, minDescribeEventsRecords)))
+	input.Duration = aws.Int32(eventsConfig.durationMinutes())
+	resp, err := sdkapi.DescribeEvents(ctx, input)
+	metrics.RecordAPICall("READ_MANY", "DescribeEvents-"+eventSourceKinds[sourceType], err)
+	if err != nil {
+		return nil, err
+	}
+	events := []*svcapitypes.Event{}
+	for _, respEvent := range resp.Events {
+		// Not copying redundant source id and source type
+		// into each event object
+		events = append(events, newEvent(respEvent))
+	}
+	if len(events) > eventsConfig.MaxRecords {
+		events = events[:eventsConfig.MaxRecords]
+	}
+	return events, nil
+}
+
+// newEvent returns the Event reported in the resource status for an
+// ElastiCache event.
+func newEvent(e svcsdktypes.Event) *svcapitypes.Event {
+	event := &svcapitypes.Event{}
+	if e.Message != nil {
+		event.Message = e.Message
+	}
+	if e.Date != nil {
+		eventDate := metav1.NewTime(*e.Date)
+		event.Date = &eventDate
+	}
+	return event
+}
+
+// RecordEvents emits a Kubernetes Event on obj for each of the supplied
+// ElastiCache events that has not been emitted yet, oldest first, and then
+// keeps the newest emitted events in memory. Only events dated after both the
+// creation of obj and the start of the controller are emitted.
+func RecordEvents(
+	obj ctrlrtclient.Object,
+	events []*svcapitypes.Event,
+) {
+	if eventRecorder == nil {
+		return
+	}
+	emittedEventsMu.Lock()
+	defer emittedEventsMu.Unlock()
+	forgetExpiredEvents()
+
+	last := lastEmittedEvents(obj)
+	newEvents := unemittedEvents(events, last.time, last.hashes)
+	if len(newEvents) == 0 {
+		return
+	}
+	for _, e := range newEvents {
+		eventRecorder.Eventf(
+			obj, nil, eventType(*e.Message), EventReasonElastiCache,
+			eventActionDescribeEvents, "%s", *e.Message,
+		)
+	}
+
+	newest := newEvents[len(newEvents)-1].Date.Time
+	if !newest.Equal(last.time) {
+		last = emittedEvents{time: newest, hashes: map[string]bool{}}
+	}
+	for _, e := range newEvents {
+		if e.Date.Time.Equal(newest) {
+			last.hashes[eventHash(*e.Message)] = true
+		}
+	}
+	lastEmittedEventsByUID[obj.GetUID()] = last
+}
+
+// forgetExpiredEvents drops the emitted events dated before the window of
+// described events, which cannot be described again, so that the events of
+// deleted resources are eventually dropped too. emittedEventsMu must be held.
+func forgetExpiredEvents() {
+	expired := time.Now().Add(-eventsConfig.Window)
+	for uid, last := range lastEmittedEventsByUID {
+		if last.time.Before(expired) {
+			delete(lastEmittedEventsByUID, uid)
+		}
+	}
+}
+
+// unemittedEvents returns the supplied events that are dated after lastTime,
+// or at lastTime with a message hash not in lastEvents, oldest first.
+func unemittedEvents(
+	events []*svcapitypes.Event,
+	lastTime time.Time,
+	lastEvents map[string]bool,
+) []*svcapitypes.Event {
+	newEvents := []*svcapitypes.Event{}
+	for _, e := range events {
+		if e == nil || e.Date == nil || e.Message == nil {
+			continue
+		}
+		if e.Date.Time.Before(lastTime) ||
+			e.Date.Time.Equal(lastTime) && lastEvents[eventHash(*e.Message)] {
+			continue
+		}
+		newEvents = append(newEvents, e)
+	}
+	sort.SliceStable(newEvents, func(i, j int) bool {
+		return newEvents[i].Date.Time.Before(newEvents[j].Date.Time)
+	})
+	return newEvents
+}
+
+// lastEmittedEvents returns the newest events emitted for obj. Without
+// emitted events, the latest of the creation time of obj and emittingSince is
+// returned. emittedEventsMu must be held.
+func lastEmittedEvents(obj ctrlrtclient.Object) emittedEvents {
+	if last, ok := lastEmittedEventsByUID[obj.GetUID()]; ok {
+		return last
+	}
+	since := obj.GetCreationTimestamp().Time
+	if since.Before(emittingSince) {
+		since = emittingSince
+	}
+	return emittedEvents{time: since, hashes: map[string]bool{}}
+}
+
+// eventHash returns the hash of an event message recorded in the
+// AnnotationLastEvents annotation.
+func eventHash(message string) string {
+	h := fnv.New32a()
+	h.Write([]byte(message))
+	return strconv.FormatUint(uint64(h.Sum32()), 16)
+}
+
+// eventType returns the Kubernetes Event type for an ElastiCache event
+// message. Messages reporting a failure or an error are warnings.
+func eventType(message string) string {
+	lower := strings.ToLower(message)
+	if strings.Contains(lower, "fail") || strings.Contains(lower, "error") {
+		return corev1.EventTypeWarning
+	}
+	return corev1.EventTypeNormal
+}
@@ -0,0 +1,115 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"time"
+
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	flagEventsWindow       = "events-window"
+	flagEventsMaxRecords   = "events-max-records"
+	flagEventsPollInterval = "events-poll-interval"
+
+	// maxEventsWindow is the longest window of events DescribeEvents can
+	// return.
+	maxEventsWindow = 14 * 24 * time.Hour
+	// minDescribeEventsRecords and maxDescribeEventsRecords bound the
+	// MaxRecords parameter of DescribeEvents.
+	minDescribeEventsRecords = 20
+	maxDescribeEventsRecords = 100
+
+	defaultEventsMaxRecords = 20
+	// The shared event poller is disabled by default. Its first poll
+	// describes the events of the whole window for every ElastiCache
+	// resource of the account and region, including those not managed by
+	// the controller.
+	defaultEventsPollInterval = time.Duration(0)
+)
+
+// EventsConfig configures how ElastiCache events are described for the
+// resources that report them.
+type EventsConfig struct {
+	// Window is how far back events are described.
+	Window time.Duration
+	// MaxRecords is the number of latest events kept for each resource.
+	MaxRecords int
+	// PollInterval is how often the shared event poller of an account and
+	// region describes the events of all its sources. Zero disables the
+	// poller, and each resource then describes its own events.
+	PollInterval time.Duration
+}
+
+// eventsConfig is the EventsConfig used by DescribeEvents.
+var eventsConfig = EventsConfig{
+	Window:       maxEventsWindow,
+	MaxRecords:   defaultEventsMaxRecords,
+	PollInterval: defaultEventsPollInterval,
+}
+
+// SetEventsConfig stores the EventsConfig used by DescribeEvents.
+func SetEventsConfig(cfg EventsConfig) {
+	eventsConfig = cfg
+}
+
+// BindFlags defines the command line flags of the events configuration.
+func (cfg *EventsConfig) BindFlags() {
+	flag.DurationVar(
+		&cfg.Window, flagEventsWindow,
+		maxEventsWindow,
+		"How far back ElastiCache events are described, at most 336h (14 days).",
+	)
+	flag.IntVar(
+		&cfg.MaxRecords, flagEventsMaxRecords,
+		defaultEventsMaxRecords,
+		fmt.Sprintf("The number of latest ElastiCache events kept for each resource, at most %d.", maxDescribeEventsRecords),
+	)
+	flag.DurationVar(
+		&cfg.PollInterval, flagEventsPollInterval,
+		defaultEventsPollInterval,
+		"How often the ElastiCache events of all resources in an account and region are described "+
+			"by a single shared poller. The first poll describes the whole events window of the account "+
+			"and region, including resources not managed by the controller. Defaults to 0, which "+
+			"disables the poller and describes the events of each resource separately.",
+	)
+}
+
+// Validate returns an error if the events configuration is invalid.
+func (cfg EventsConfig) Validate() error {
+	if cfg.Window < time.Minute || cfg.Window > maxEventsWindow {
+		return fmt.Errorf(
+			"invalid value for flag '%s': window must be between 1m0s and %s",
+			flagEventsWindow, maxEventsWindow,
+		)
+	}
+	if cfg.MaxRecords < 1 || cfg.MaxRecords > maxDescribeEventsRecords {
+		return fmt.Errorf(
+			"invalid value for flag '%s': max records must be between 1 and %d",
+			flagEventsMaxRecords, maxDescribeEventsRecords,
+		)
+	}
+	if cfg.PollInterval < 0 {
+		return fmt.Errorf("invalid value for flag '%s': poll interval must not be negative", flagEventsPollInterval)
+	}
+	return nil
+}
+
+// durationMinutes returns the window as the Duration parameter of
+// DescribeEvents.
+func (cfg EventsConfig) durationMinutes() int32 {
+	return int32(cfg.Window / time.Minute)
+}
@@ -0,0 +1,114 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+
+	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+)
+
+func Test_unemittedEvents(t *testing.T) {
+	last := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	event := func(at time.Time, message string) *svcapitypes.Event {
+		date := metav1.NewTime(at)
+		return &svcapitypes.Event{Date: &date, Message: aws.String(message)}
+	}
+	events := []*svcapitypes.Event{
+		event(last.Add(time.Second), "Failover to replica node 0002 completed"),
+		event(last, "Failover to replica node 0001 completed"),
+		event(last, "Failover from primary node 0001 started"),
+		event(last.Add(-time.Second), "Modifying replication group"),
+		{Message: aws.String("undated")},
+	}
+
+	got := unemittedEvents(events, last, map[string]bool{
+		eventHash("Failover from primary node 0001 started"): true,
+	})
+	want := []string{
+		"Failover to replica node 0001 completed",
+		"Failover to replica node 0002 completed",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("unemittedEvents() returned %d events, want %d", len(got), len(want))
+	}
+	for i, e := range got {
+		if *e.Message != want[i] {
+			t.Errorf("unemittedEvents()[%d] = %q, want %q", i, *e.Message, want[i])
+		}
+	}
+}
+
+func Test_lastEmittedEvents(t *testing.T) {
+	defer func(since time.Time) { emittingSince = since }(emittingSince)
+	emittingSince = time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC)
+
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	obj := &svcapitypes.ReplicationGroup{ObjectMeta: metav1.ObjectMeta{
+		UID:               "uid",
+		CreationTimestamp: metav1.NewTime(created),
+	}}
+	if got := lastEmittedEvents(obj); !got.time.Equal(emittingSince) || len(got.hashes) != 0 {
+		t.Errorf("lastEmittedEvents() = %s, %v for a resource created before the controller started, "+
+			"want the start of the controller", got.time, got.hashes)
+	}
+	obj.CreationTimestamp = metav1.NewTime(created.Add(8 * time.Hour))
+	if got := lastEmittedEvents(obj); !got.time.Equal(obj.CreationTimestamp.Time) {
+		t.Errorf("lastEmittedEvents() = %s for a resource created after the controller started, "+
+			"want its creation time", got.time)
+	}
+}
+
+func Test_RecordEvents(t *testing.T) {
+	defer SetEventRecorder(nil)
+	recorder := events.NewFakeRecorder(10)
+	SetEventRecorder(recorder)
+	defer func(since time.Time) { emittingSince = since }(emittingSince)
+	emittingSince = time.Now().Add(-time.Hour)
+
+	event := func(at time.Time, message string) *svcapitypes.Event {
+		date := metav1.NewTime(at)
+		return &svcapitypes.Event{Date: &date, Message: aws.String(message)}
+	}
+	obj := &svcapitypes.ReplicationGroup{ObjectMeta: metav1.ObjectMeta{UID: "record-events"}}
+	defer delete(lastEmittedEventsByUID, obj.UID)
+
+	at := time.Now().Add(-time.Minute).Truncate(time.Second)
+	described := []*svcapitypes.Event{
+		event(at, "Failover from primary node 0001 started"),
+		event(at.Add(-2*time.Hour), "Replication group created"),
+	}
+	RecordEvents(obj, described)
+	if len(recorder.Events) != 1 {
+		t.Fatalf("emitted %d events, want the event dated after the start of the controller", len(recorder.Events))
+	}
+	<-recorder.Events
+
+	// events already emitted are not emitted again
+	described = append([]*svcapitypes.Event{event(at, "Failover to replica node 0002 completed")}, described...)
+	RecordEvents(obj, described)
+	if len(recorder.Events) != 1 {
+		t.Fatalf("emitted %d events, want only the new event", len(recorder.Events))
+	}
+	<-recorder.Events
+	RecordEvents(obj, described)
+	if len(recorder.Events) != 0 {
+		t.Errorf("emitted %d events, want none", len(recorder.Events))
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// kubeClient is the Kubernetes client of the controller manager. The ACK
+// runtime does not hand a Kubernetes client to resource managers, so it is
+// stored here once the controller manager has been created.
+var kubeClient ctrlrtclient.Client
+
+// SetKubeClient stores the Kubernetes client that resource managers use to
+// manage Kubernetes objects other than the resource being reconciled.
+func SetKubeClient(c ctrlrtclient.Client) {
+	kubeClient = c
+}
+
+// KubeClient returns the Kubernetes client stored by SetKubeClient, or nil if
+// none was stored.
+func KubeClient() ctrlrtclient.Client {
+	return kubeClient
+}
+
+// apiReader reads Kubernetes objects from the API server without going
+// through the cache of the controller manager, so that reading an object
+// does not start an informer for its kind.
+var apiReader ctrlrtclient.Reader
+
+// SetAPIReader stores the uncached reader that resource managers use to read
+// Kubernetes objects that are not watched by the controller manager.
+func SetAPIReader(r ctrlrtclient.Reader) {
+	apiReader = r
+}
+
+// APIReader returns the reader stored by SetAPIReader, or nil if none was
+// stored.
+func APIReader() ctrlrtclient.Reader {
+	return apiReader
+}
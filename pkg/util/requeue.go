@@ -0,0 +1,301 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"errors"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Transition is a long-running change of a resource that the controller
+// waits for.
+type Transition string
+
+const (
+	TransitionCreating     Transition = "creating"
+	TransitionModifying    Transition = "modifying"
+	TransitionSnapshotting Transition = "snapshotting"
+	TransitionDeleting     Transition = "deleting"
+)
+
+// requeuePolicy is the range of delays between reconciles of a resource
+// waiting for a transition. The delay starts at initial and doubles as the
+// transition goes on, up to max.
+type requeuePolicy struct {
+	initial time.Duration
+	max     time.Duration
+}
+
+// defaultRequeuePolicy is used for kinds and transitions without a policy
+// in requeuePolicies.
+var defaultRequeuePolicy = requeuePolicy{ackrequeue.DefaultRequeueAfterDuration, 5 * time.Minute}
+
+// requeuePolicies are the requeue policies of each kind and transition.
+// Replication groups and cache clusters take ten minutes or more to create,
+// serverless caches and user groups usually a minute or two.
+var requeuePolicies = map[string]map[Transition]requeuePolicy{
+	"ReplicationGroup": {
+		TransitionCreating:     {time.Minute, 5 * time.Minute},
+		TransitionModifying:    {30 * time.Second, 5 * time.Minute},
+		TransitionSnapshotting: {time.Minute, 5 * time.Minute},
+		TransitionDeleting:     {30 * time.Second, 3 * time.Minute},
+	},
+	"CacheCluster": {
+		TransitionCreating:     {time.Minute, 5 * time.Minute},
+		TransitionModifying:    {30 * time.Second, 5 * time.Minute},
+		TransitionSnapshotting: {time.Minute, 5 * time.Minute},
+		TransitionDeleting:     {30 * time.Second, 3 * time.Minute},
+	},
+	"ServerlessCache": {
+		TransitionCreating:  {10 * time.Second, 2 * time.Minute},
+		TransitionModifying: {10 * time.Second, 2 * time.Minute},
+		TransitionDeleting:  {10 * time.Second, time.Minute},
+	},
+	"UserGroup": {
+		TransitionModifying: {10 * time.Second, time.Minute},
+	},
+}
+
+// transitionStart is when a resource was first and last seen in a
+// transition, and how long after it was last seen the transition is
+// forgotten.
+type transitionStart struct {
+	transition Transition
+	at         time.Time
+	seen       time.Time
+	expiry     time.Duration
+}
+
+// expired returns true if the transition was last seen longer than its expiry
+// before now.
+func (s transitionStart) expired(now time.Time) bool {
+	return now.Sub(s.seen) > s.expiry
+}
+
+// transitionSweepInterval is how often expired transitions are forgotten,
+// so that resources that were deleted, or that stopped being reconciled
+// while in a transition, are not kept forever.
+const transitionSweepInterval = time.Minute
+
+var (
+	transitionStartsMu sync.Mutex
+	// transitionStarts are the transitions resources are waiting for, keyed
+	// by kind, namespace and name.
+	transitionStarts = map[string]transitionStart{}
+	// transitionsSweptAt is when expired transitions were last forgotten.
+	transitionsSweptAt time.Time
+)
+
+// TransitionFromStatus returns the transition of a resource in the supplied
+// status, or TransitionModifying for statuses other than creating,
+// snapshotting and deleting.
+func TransitionFromStatus(status *string) Transition {
+	if status == nil {
+		return TransitionModifying
+	}
+	switch t := Transition(*status); t {
+	case TransitionCreating, TransitionSnapshotting, TransitionDeleting:
+		return t
+	}
+	return TransitionModifying
+}
+
+// RequeueWaitWhile returns a requeue error that reconciles the resource of
+// the kind again once the transition has likely progressed. The delay grows
+// with the time the resource has been in the transition, and is extended
+// while ElastiCache API requests of the account and region are throttled.
+func RequeueWaitWhile(
+	err error,
+	kind string,
+	obj metav1.Object,
+	transition Transition,
+	accountID ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+) *ackrequeue.RequeueNeededAfter {
+	after := RequeueAfter(kind, obj, transition, time.Now())
+	return ackrequeue.NeededAfter(err, after+ThrottledFor(accountID, region))
+}
+
+// RequeueAfter returns the delay before reconciling again the resource of the
+// kind that is in the transition at now.
+func RequeueAfter(
+	kind string,
+	obj metav1.Object,
+	transition Transition,
+	now time.Time,
+) time.Duration {
+	policy, ok := requeuePolicies[kind][transition]
+	if !ok {
+		policy = defaultRequeuePolicy
+	}
+	started := transitionStartedAt(kind, obj, transition, 2*policy.max, now)
+	return jitter(backoff(policy, now.Sub(started)))
+}
+
+// transitionStartedAt returns when the resource entered the transition. A
+// resource is creating since it was created; for other transitions, the
+// start is the first time the resource was seen in the transition, unless it
+// was last seen in it longer than expiry ago.
+func transitionStartedAt(
+	kind string,
+	obj metav1.Object,
+	transition Transition,
+	expiry time.Duration,
+	now time.Time,
+) time.Time {
+	created := obj.GetCreationTimestamp()
+	if transition == TransitionCreating && !created.IsZero() {
+		return created.Time
+	}
+	key := transitionKey(kind, obj)
+	transitionStartsMu.Lock()
+	defer transitionStartsMu.Unlock()
+	if now.Sub(transitionsSweptAt) >= transitionSweepInterval {
+		for k, start := range transitionStarts {
+			if start.expired(now) {
+				delete(transitionStarts, k)
+			}
+		}
+		transitionsSweptAt = now
+	}
+	start, ok := transitionStarts[key]
+	if !ok || start.transition != transition || start.expired(now) {
+		start.transition, start.at = transition, now
+	}
+	start.seen, start.expiry = now, expiry
+	transitionStarts[key] = start
+	return start.at
+}
+
+// EndTransition forgets the transition of the resource of the kind. It is
+// called once the resource is no longer in a transition.
+func EndTransition(kind string, obj metav1.Object) {
+	key := transitionKey(kind, obj)
+	transitionStartsMu.Lock()
+	defer transitionStartsMu.Unlock()
+	delete(transitionStarts, key)
+}
+
+func transitionKey(kind string, obj metav1.Object) string {
+	return kind + "/" + obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// backoff returns the delay of the policy for a transition that has lasted
+// elapsed. The delay doubles each time the previous delays have elapsed.
+func backoff(policy requeuePolicy, elapsed time.Duration) time.Duration {
+	delay := policy.initial
+	for waited := delay; waited <= elapsed && delay < policy.max; waited += delay {
+		delay *= 2
+	}
+	return min(delay, policy.max)
+}
+
+// jitter returns the delay extended by up to a fifth, so that resources
+// created or changed together are not reconciled in lockstep.
+func jitter(delay time.Duration) time.Duration {
+	if delay < 5 {
+		return delay
+	}
+	return delay + rand.N(delay/5)
+}
+
+const (
+	minThrottleBackoff = 5 * time.Second
+	maxThrottleBackoff = 5 * time.Minute
+)
+
+var errThrottled = errors.New("ElastiCache API requests are throttled, backing off")
+
+// throttle is the controller-level backoff of an account and region after
+// ElastiCache API requests were throttled.
+type throttle struct {
+	backoff time.Duration
+	until   time.Time
+}
+
+var (
+	throttlesMu sync.Mutex
+	// throttles are the backoffs of each account and region.
+	throttles = map[string]*throttle{}
+)
+
+// isThrottlingError returns true if err is a throttling error of the AWS API.
+func isThrottlingError(err error) bool {
+	return err != nil && retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool()
+}
+
+// RecordThrottling starts or extends the backoff of the account and region if
+// err is a throttling error, and returns whether it was. The backoff doubles
+// with each throttling error received while backing off, and is reset once
+// no request has been throttled for as long as the backoff.
+func RecordThrottling(
+	accountID ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+	err error,
+) bool {
+	if !isThrottlingError(err) {
+		return false
+	}
+	key := string(accountID) + "/" + string(region)
+	now := time.Now()
+	throttlesMu.Lock()
+	defer throttlesMu.Unlock()
+	t, ok := throttles[key]
+	if !ok {
+		t = &throttle{}
+		throttles[key] = t
+	}
+	if now.After(t.until.Add(t.backoff)) {
+		t.backoff = 0
+	}
+	t.backoff = min(max(2*t.backoff, minThrottleBackoff), maxThrottleBackoff)
+	t.until = now.Add(jitter(t.backoff))
+	return true
+}
+
+// ThrottledFor returns how long the account and region are still backing off
+// after ElastiCache API requests were throttled.
+func ThrottledFor(
+	accountID ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+) time.Duration {
+	key := string(accountID) + "/" + string(region)
+	throttlesMu.Lock()
+	defer throttlesMu.Unlock()
+	t, ok := throttles[key]
+	if !ok {
+		return 0
+	}
+	return max(time.Until(t.until), 0)
+}
+
+// RequeueWhileThrottled returns a requeue error if the account and region are
+// backing off after ElastiCache API requests were throttled, so that the
+// resource is reconciled again without calling the API until then.
+func RequeueWhileThrottled(
+	accountID ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+) error {
+	if after := ThrottledFor(accountID, region); after > 0 {
+		return ackrequeue.NeededAfter(errThrottled, after)
+	}
+	return nil
+}
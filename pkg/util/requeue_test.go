@@ -0,0 +1,155 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_backoff(t *testing.T) {
+	policy := requeuePolicy{30 * time.Second, 5 * time.Minute}
+	tests := []struct {
+		elapsed time.Duration
+		want    time.Duration
+	}{
+		{0, 30 * time.Second},
+		{29 * time.Second, 30 * time.Second},
+		{30 * time.Second, time.Minute},
+		{90 * time.Second, 2 * time.Minute},
+		{210 * time.Second, 4 * time.Minute},
+		{450 * time.Second, 5 * time.Minute},
+		{time.Hour, 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := backoff(policy, tt.elapsed); got != tt.want {
+			t.Errorf("backoff(%s) = %s, want %s", tt.elapsed, got, tt.want)
+		}
+	}
+}
+
+func Test_jitter(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		got := jitter(time.Minute)
+		if got < time.Minute || got >= time.Minute+12*time.Second {
+			t.Fatalf("jitter(1m) = %s, want within [1m, 1m12s)", got)
+		}
+	}
+}
+
+func Test_RequeueAfter(t *testing.T) {
+	now := time.Now()
+	obj := &metav1.ObjectMeta{
+		Namespace:         "default",
+		Name:              "test-requeue-after",
+		CreationTimestamp: metav1.NewTime(now.Add(-10 * time.Minute)),
+	}
+
+	// creating is timed from the creation of the resource
+	got := RequeueAfter("ReplicationGroup", obj, TransitionCreating, now)
+	if got < 5*time.Minute || got >= 6*time.Minute {
+		t.Errorf("RequeueAfter(creating) = %s, want the 5m maximum with jitter", got)
+	}
+
+	// other transitions are timed from when the resource is first seen in them
+	got = RequeueAfter("ReplicationGroup", obj, TransitionModifying, now)
+	if got < 30*time.Second || got >= 36*time.Second {
+		t.Errorf("RequeueAfter(modifying) = %s, want the 30s initial delay with jitter", got)
+	}
+	got = RequeueAfter("ReplicationGroup", obj, TransitionModifying, now.Add(time.Minute))
+	if got < time.Minute || got >= 72*time.Second {
+		t.Errorf("RequeueAfter(modifying) after 1m = %s, want 1m with jitter", got)
+	}
+
+	// a transition not seen for longer than twice the maximum delay starts over
+	got = RequeueAfter("ReplicationGroup", obj, TransitionModifying, now.Add(time.Hour))
+	if got < 30*time.Second || got >= 36*time.Second {
+		t.Errorf("RequeueAfter(modifying) after 1h = %s, want the 30s initial delay with jitter", got)
+	}
+
+	// kinds without a policy use the default policy
+	got = RequeueAfter("Unknown", obj, TransitionDeleting, now)
+	if got < defaultRequeuePolicy.initial {
+		t.Errorf("RequeueAfter(unknown kind) = %s, want at least %s", got, defaultRequeuePolicy.initial)
+	}
+}
+
+func Test_EndTransition(t *testing.T) {
+	now := time.Now()
+	obj := &metav1.ObjectMeta{Namespace: "default", Name: "test-end-transition"}
+	other := &metav1.ObjectMeta{Namespace: "default", Name: "test-end-transition-other"}
+	key := transitionKey("CacheCluster", obj)
+
+	RequeueAfter("CacheCluster", obj, TransitionModifying, now)
+	EndTransition("CacheCluster", obj)
+	if _, ok := transitionStarts[key]; ok {
+		t.Errorf("transition kept after EndTransition()")
+	}
+
+	// a resource that is no longer reconciled is forgotten once its
+	// transition expires
+	transitionsSweptAt = time.Time{}
+	RequeueAfter("CacheCluster", obj, TransitionModifying, now)
+	RequeueAfter("CacheCluster", other, TransitionModifying, now.Add(time.Hour))
+	if _, ok := transitionStarts[key]; ok {
+		t.Errorf("expired transition kept after a sweep")
+	}
+	EndTransition("CacheCluster", other)
+}
+
+func Test_TransitionFromStatus(t *testing.T) {
+	for status, want := range map[string]Transition{
+		"creating":                TransitionCreating,
+		"snapshotting":            TransitionSnapshotting,
+		"deleting":                TransitionDeleting,
+		"modifying":               TransitionModifying,
+		"rebooting cluster nodes": TransitionModifying,
+	} {
+		if got := TransitionFromStatus(&status); got != want {
+			t.Errorf("TransitionFromStatus(%q) = %q, want %q", status, got, want)
+		}
+	}
+}
+
+func Test_RecordThrottling(t *testing.T) {
+	if RecordThrottling("111122223333", "us-west-2", errors.New("boom")) {
+		t.Errorf("RecordThrottling() recorded an error that is not a throttling error")
+	}
+	if got := ThrottledFor("111122223333", "us-west-2"); got != 0 {
+		t.Errorf("ThrottledFor() = %s, want 0", got)
+	}
+
+	err := &smithy.GenericAPIError{Code: "Throttling", Message: "Rate exceeded"}
+	if !RecordThrottling("111122223333", "us-west-2", err) {
+		t.Fatalf("RecordThrottling() did not record a throttling error")
+	}
+	first := ThrottledFor("111122223333", "us-west-2")
+	if first <= 0 || first > minThrottleBackoff+minThrottleBackoff/5 {
+		t.Errorf("ThrottledFor() = %s, want up to %s with jitter", first, minThrottleBackoff)
+	}
+	RecordThrottling("111122223333", "us-west-2", err)
+	if second := ThrottledFor("111122223333", "us-west-2"); second < 2*minThrottleBackoff-time.Second {
+		t.Errorf("ThrottledFor() after a second throttling error = %s, want about %s", second, 2*minThrottleBackoff)
+	}
+	if err := RequeueWhileThrottled("111122223333", "us-west-2"); err == nil {
+		t.Errorf("RequeueWhileThrottled() = nil, want a requeue error")
+	}
+	if err := RequeueWhileThrottled("111122223333", "eu-west-1"); err != nil {
+		t.Errorf("RequeueWhileThrottled() of another region = %v, want nil", err)
+	}
+}
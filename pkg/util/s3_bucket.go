@@ -0,0 +1,99 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:rbac:groups=s3.services.k8s.aws,resources=buckets,verbs=get;list
+// +kubebuilder:rbac:groups=s3.services.k8s.aws,resources=buckets/status,verbs=get;list
+
+// bucketGVK is the GroupVersionKind of the ACK S3 controller's Bucket
+// resource. The S3 controller's API types are not a dependency of this
+// controller, so referenced Buckets are read as unstructured objects, and the
+// Bucket references are resolved from the Snapshot and ServerlessCacheSnapshot
+// hooks instead of being declared in generator.yaml.
+var bucketGVK = schema.GroupVersionKind{
+	Group:   "s3.services.k8s.aws",
+	Version: "v1alpha1",
+	Kind:    "Bucket",
+}
+
+// GetReferencedBucketName looks up the ACK S3 Bucket with the supplied
+// Kubernetes name and namespace and returns the name of the S3 bucket it
+// manages. It returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` if the Bucket is in a Terminal state or is
+// not yet synced.
+func GetReferencedBucketName(
+	ctx context.Context,
+	apiReader client.Reader,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) (string, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(bucketGVK)
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	if err := apiReader.Get(ctx, namespacedName, obj); err != nil {
+		return "", err
+	}
+	conditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil {
+		return "", err
+	}
+	var refResourceSynced bool
+	for _, c := range conditions {
+		cond, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if cond["status"] != "True" {
+			continue
+		}
+		switch cond["type"] {
+		case string(ackv1alpha1.ConditionTypeTerminal):
+			return "", ackerr.ResourceReferenceTerminalFor(
+				"Bucket",
+				namespace, name)
+		case string(ackv1alpha1.ConditionTypeResourceSynced):
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return "", ackerr.ResourceReferenceNotSyncedFor(
+			"Bucket",
+			namespace, name)
+	}
+	bucketName, found, err := unstructured.NestedString(obj.Object, "spec", "name")
+	if err != nil {
+		return "", err
+	}
+	if !found || bucketName == "" {
+		return "", ackerr.ResourceReferenceMissingTargetFieldFor(
+			"Bucket",
+			namespace, name,
+			"Spec.Name")
+	}
+	return bucketName, nil
+}
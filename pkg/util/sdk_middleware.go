@@ -0,0 +1,134 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/go-logr/logr"
+)
+
+// cachedOutputKey is the context key of a cachedOutput.
+type cachedOutputKey struct{}
+
+// cachedOutput is the output of an ElastiCache API operation that is served
+// once instead of calling the API.
+type cachedOutput struct {
+	operation string
+	output    interface{}
+	served    atomic.Bool
+}
+
+// WithCachedOutput returns a context in which the next call of the
+// ElastiCache API operation returns output without calling the API. It lets
+// the read of a resource be served from the describe cache through the same
+// code that sets the output of the API call on the resource.
+func WithCachedOutput(
+	ctx context.Context,
+	operation string,
+	output interface{},
+) context.Context {
+	return context.WithValue(ctx, cachedOutputKey{}, &cachedOutput{
+		operation: operation,
+		output:    output,
+	})
+}
+
+// serveCachedOutput returns the output stored by WithCachedOutput for the
+// operation being called, if it was not served yet.
+var serveCachedOutput = middleware.InitializeMiddlewareFunc(
+	"ServeCachedOutput",
+	func(
+		ctx context.Context,
+		in middleware.InitializeInput,
+		next middleware.InitializeHandler,
+	) (middleware.InitializeOutput, middleware.Metadata, error) {
+		c, ok := ctx.Value(cachedOutputKey{}).(*cachedOutput)
+		if ok && c.operation == awsmiddleware.GetOperationName(ctx) && c.served.CompareAndSwap(false, true) {
+			return middleware.InitializeOutput{Result: c.output}, middleware.Metadata{}, nil
+		}
+		return next.HandleInitialize(ctx, in)
+	},
+)
+
+// recordThrottling returns a middleware recording the throttling errors
+// returned by the ElastiCache API to the account and region once the SDK gave
+// up retrying, so that the controller backs off the requests of the account
+// and region.
+func recordThrottling(
+	accountID ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+) middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc(
+		"RecordThrottling",
+		func(
+			ctx context.Context,
+			in middleware.InitializeInput,
+			next middleware.InitializeHandler,
+		) (middleware.InitializeOutput, middleware.Metadata, error) {
+			out, metadata, err := next.HandleInitialize(ctx, in)
+			RecordThrottling(accountID, region, err)
+			return out, metadata, err
+		},
+	)
+}
+
+// sdkMiddlewareFactory is a resource manager factory whose resource managers
+// call the ElastiCache API through the middleware of the controller.
+type sdkMiddlewareFactory struct {
+	acktypes.AWSResourceManagerFactory
+}
+
+// ManagerFor returns the resource manager of the wrapped factory, with the
+// middleware of the controller added to its AWS client configuration.
+func (f sdkMiddlewareFactory) ManagerFor(
+	cfg ackcfg.Config,
+	clientcfg aws.Config,
+	log logr.Logger,
+	metrics *ackmetrics.Metrics,
+	rr acktypes.Reconciler,
+	id ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+	roleARN ackv1alpha1.AWSResourceName,
+) (acktypes.AWSResourceManager, error) {
+	clientcfg.APIOptions = append(slices.Clip(clientcfg.APIOptions), func(stack *middleware.Stack) error {
+		if err := stack.Initialize.Add(recordThrottling(id, region), middleware.After); err != nil {
+			return err
+		}
+		return stack.Initialize.Add(serveCachedOutput, middleware.After)
+	})
+	return f.AWSResourceManagerFactory.ManagerFor(cfg, clientcfg, log, metrics, rr, id, region, roleARN)
+}
+
+// WithSDKMiddleware returns the supplied resource manager factories, with the
+// ElastiCache API called through the middleware of the controller by the
+// resource managers they produce.
+func WithSDKMiddleware(
+	factories []acktypes.AWSResourceManagerFactory,
+) []acktypes.AWSResourceManagerFactory {
+	wrapped := make([]acktypes.AWSResourceManagerFactory, 0, len(factories))
+	for _, f := range factories {
+		wrapped = append(wrapped, sdkMiddlewareFactory{f})
+	}
+	return wrapped
+}
@@ -0,0 +1,139 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/go-logr/logr"
+)
+
+// unreachableHTTPClient fails every request, so that only outputs served by
+// the middleware of the controller succeed.
+type unreachableHTTPClient struct{}
+
+func (unreachableHTTPClient) Do(*http.Request) (*http.Response, error) {
+	return nil, errors.New("unreachable")
+}
+
+// throttlingHTTPClient throttles every request.
+type throttlingHTTPClient struct{}
+
+func (throttlingHTTPClient) Do(*http.Request) (*http.Response, error) {
+	body := `<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code>` +
+		`<Message>Rate exceeded</Message></Error><RequestId>id</RequestId></ErrorResponse>`
+	return &http.Response{
+		StatusCode: http.StatusBadRequest,
+		Header:     http.Header{"Content-Type": []string{"text/xml"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}, nil
+}
+
+func newTestSDKClient(
+	httpClient aws.HTTPClient,
+	accountID ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+) *svcsdk.Client {
+	var sdkapi *svcsdk.Client
+	f := sdkMiddlewareFactory{testManagerFactory{func(clientcfg aws.Config) {
+		sdkapi = svcsdk.NewFromConfig(clientcfg)
+	}}}
+	clientcfg := aws.Config{
+		Region:           string(region),
+		Credentials:      aws.AnonymousCredentials{},
+		HTTPClient:       httpClient,
+		RetryMaxAttempts: 1,
+	}
+	_, _ = f.ManagerFor(ackcfg.Config{}, clientcfg, logr.Discard(), nil, nil, accountID, region, "")
+	return sdkapi
+}
+
+// testManagerFactory passes the AWS client configuration of the resource
+// managers it is asked for to newClient.
+type testManagerFactory struct {
+	newClient func(aws.Config)
+}
+
+func (f testManagerFactory) ResourceDescriptor() acktypes.AWSResourceDescriptor {
+	return nil
+}
+
+func (f testManagerFactory) ManagerFor(
+	_ ackcfg.Config,
+	clientcfg aws.Config,
+	_ logr.Logger,
+	_ *ackmetrics.Metrics,
+	_ acktypes.Reconciler,
+	_ ackv1alpha1.AWSAccountID,
+	_ ackv1alpha1.AWSRegion,
+	_ ackv1alpha1.AWSResourceName,
+) (acktypes.AWSResourceManager, error) {
+	f.newClient(clientcfg)
+	return nil, nil
+}
+
+func (f testManagerFactory) IsAdoptable() bool {
+	return false
+}
+
+func (f testManagerFactory) RequeueOnSuccessSeconds() int {
+	return 0
+}
+
+func Test_serveCachedOutput(t *testing.T) {
+	sdkapi := newTestSDKClient(unreachableHTTPClient{}, "111111111111", "us-west-2")
+	cached := &svcsdk.DescribeReplicationGroupsOutput{
+		ReplicationGroups: []svcsdktypes.ReplicationGroup{{ReplicationGroupId: aws.String("rg")}},
+	}
+	ctx := WithCachedOutput(context.Background(), "DescribeReplicationGroups", cached)
+
+	if _, err := sdkapi.DescribeCacheClusters(ctx, &svcsdk.DescribeCacheClustersInput{}); err == nil {
+		t.Error("DescribeCacheClusters() error = nil, want the API to be called for another operation")
+	}
+	resp, err := sdkapi.DescribeReplicationGroups(ctx, &svcsdk.DescribeReplicationGroupsInput{})
+	if err != nil {
+		t.Fatalf("DescribeReplicationGroups() error = %v", err)
+	}
+	if resp != cached {
+		t.Errorf("DescribeReplicationGroups() = %v, want the cached output", resp)
+	}
+	if _, err := sdkapi.DescribeReplicationGroups(ctx, &svcsdk.DescribeReplicationGroupsInput{}); err == nil {
+		t.Error("DescribeReplicationGroups() error = nil, want the cached output to be served only once")
+	}
+}
+
+func Test_recordThrottling(t *testing.T) {
+	sdkapi := newTestSDKClient(throttlingHTTPClient{}, "222222222222", "us-west-2")
+	if ThrottledFor("222222222222", "us-west-2") > 0 {
+		t.Fatal("ThrottledFor() > 0 before any request was throttled")
+	}
+	if _, err := sdkapi.DescribeReplicationGroups(context.Background(), &svcsdk.DescribeReplicationGroupsInput{}); err == nil {
+		t.Fatal("DescribeReplicationGroups() error = nil, want a throttling error")
+	}
+	if ThrottledFor("222222222222", "us-west-2") == 0 {
+		t.Error("ThrottledFor() = 0 after a request was throttled")
+	}
+}
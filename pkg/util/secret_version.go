@@ -0,0 +1,61 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// SecretVersion returns the version of the value referenced by ref, a hash of
+// the value salted with the namespace, name and key of the reference. The
+// version only changes when the value changes or another value is
+// referenced, not when other keys or the metadata of the Secret are updated,
+// so that it never reports a rotation that did not happen. The value cannot
+// be read back from the version, which is kept in annotations. A reference
+// without a namespace points to a Secret in the supplied namespace.
+func SecretVersion(
+	ctx context.Context,
+	ref *ackv1alpha1.SecretKeyReference,
+	namespace string,
+) (string, error) {
+	reader := APIReader()
+	if reader == nil {
+		return "", errors.New("no Kubernetes client to read Secrets with")
+	}
+	if ref.Namespace != "" {
+		namespace = ref.Namespace
+	}
+	// The Secret is read without the cache, which only holds the metadata of
+	// Secrets.
+	secret := &corev1.Secret{}
+	if err := reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+		return "", err
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("key %q not found in Secret %s/%s", ref.Key, namespace, ref.Name)
+	}
+	h := sha256.New()
+	fmt.Fprintf(h, "%s/%s/%s\n", namespace, ref.Name, ref.Key)
+	h.Write(value)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,98 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrlrtfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_SecretVersion(t *testing.T) {
+	ctx := context.Background()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "token", UID: "uid"},
+		Data: map[string][]byte{
+			"token": []byte("s3cr3t-t0k3n"),
+			"other": []byte("s3cr3t-t0k3n"),
+		},
+	}
+	kc := ctrlrtfake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(secret).Build()
+	SetAPIReader(kc)
+	defer SetAPIReader(nil)
+
+	ref := &ackv1alpha1.SecretKeyReference{
+		SecretReference: corev1.SecretReference{Name: "token"},
+		Key:             "token",
+	}
+	version, err := SecretVersion(ctx, ref, "default")
+	if err != nil {
+		t.Fatalf("SecretVersion() error = %v", err)
+	}
+	if strings.Contains(version, "s3cr3t-t0k3n") {
+		t.Errorf("SecretVersion() = %q contains the secret value", version)
+	}
+
+	// updates that leave the value alone keep the version
+	secret.Labels = map[string]string{"team": "cache"}
+	secret.Data["unrelated"] = []byte("value")
+	if err := kc.Update(ctx, secret); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := SecretVersion(ctx, ref, "default"); err != nil || v != version {
+		t.Errorf("SecretVersion() = %q, %v after an unrelated update, want %q", v, err, version)
+	}
+
+	secret.Data["token"] = []byte("n3w-t0k3n")
+	if err := kc.Update(ctx, secret); err != nil {
+		t.Fatal(err)
+	}
+	updated, err := SecretVersion(ctx, ref, "default")
+	if err != nil {
+		t.Fatalf("SecretVersion() error = %v", err)
+	}
+	if updated == version {
+		t.Errorf("SecretVersion() = %q did not change after the value was updated", updated)
+	}
+
+	other := &ackv1alpha1.SecretKeyReference{
+		SecretReference: corev1.SecretReference{Name: "token"},
+		Key:             "other",
+	}
+	if v, _ := SecretVersion(ctx, other, "default"); v == version {
+		t.Errorf("SecretVersion() = %q is the same for another key with the same value", v)
+	}
+
+	missingKey := &ackv1alpha1.SecretKeyReference{
+		SecretReference: corev1.SecretReference{Name: "token"},
+		Key:             "missing",
+	}
+	if _, err := SecretVersion(ctx, missingKey, "default"); err == nil {
+		t.Error("SecretVersion() error = nil for a missing key")
+	}
+
+	missing := &ackv1alpha1.SecretKeyReference{
+		SecretReference: corev1.SecretReference{Namespace: "other", Name: "token"},
+		Key:             "token",
+	}
+	if _, err := SecretVersion(ctx, missing, "default"); err == nil {
+		t.Error("SecretVersion() error = nil for a Secret in another namespace")
+	}
+}
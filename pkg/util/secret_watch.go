@@ -0,0 +1,292 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlrtcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	ctrlrtevent "sigs.k8s.io/controller-runtime/pkg/event"
+	ctrlrthandler "sigs.k8s.io/controller-runtime/pkg/handler"
+	ctrlrtmanager "sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlrtpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	ctrlrtreconcile "sigs.k8s.io/controller-runtime/pkg/reconcile"
+	ctrlrtsource "sigs.k8s.io/controller-runtime/pkg/source"
+
+	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+)
+
+// secretRefIndexField is the name of the field index of ElastiCache resources
+// by the "namespace/name" of the Secrets they reference.
+const secretRefIndexField = "elasticache.services.k8s.aws/secret-refs"
+
+// secretDependent describes a kind of ElastiCache resource with is_secret
+// fields or a connection Secret.
+type secretDependent struct {
+	obj  ctrlrtclient.Object
+	list func() ctrlrtclient.ObjectList
+}
+
+// secretDependents are the kinds of ElastiCache resources that reference
+// Secrets, keyed by kind.
+var secretDependents = map[string]secretDependent{
+	"CacheCluster": {
+		obj:  &svcapitypes.CacheCluster{},
+		list: func() ctrlrtclient.ObjectList { return &svcapitypes.CacheClusterList{} },
+	},
+	"ReplicationGroup": {
+		obj:  &svcapitypes.ReplicationGroup{},
+		list: func() ctrlrtclient.ObjectList { return &svcapitypes.ReplicationGroupList{} },
+	},
+	"ServerlessCache": {
+		obj:  &svcapitypes.ServerlessCache{},
+		list: func() ctrlrtclient.ObjectList { return &svcapitypes.ServerlessCacheList{} },
+	},
+	"User": {
+		obj:  &svcapitypes.User{},
+		list: func() ctrlrtclient.ObjectList { return &svcapitypes.UserList{} },
+	},
+}
+
+// secretRefs returns the Secrets referenced by the is_secret fields of the
+// supplied ElastiCache resource.
+func secretRefs(obj ctrlrtclient.Object) []*ackv1alpha1.SecretKeyReference {
+	switch o := obj.(type) {
+	case *svcapitypes.CacheCluster:
+		return []*ackv1alpha1.SecretKeyReference{o.Spec.AuthToken}
+	case *svcapitypes.ReplicationGroup:
+		return []*ackv1alpha1.SecretKeyReference{o.Spec.AuthToken}
+	case *svcapitypes.User:
+		return o.Spec.Passwords
+	}
+	return nil
+}
+
+// connectionSecret returns the connection Secret published for the supplied
+// ElastiCache resource.
+func connectionSecret(obj ctrlrtclient.Object) *svcapitypes.ConnectionSecret {
+	switch o := obj.(type) {
+	case *svcapitypes.CacheCluster:
+		return o.Spec.ConnectionSecret
+	case *svcapitypes.ReplicationGroup:
+		return o.Spec.ConnectionSecret
+	case *svcapitypes.ServerlessCache:
+		return o.Spec.ConnectionSecret
+	}
+	return nil
+}
+
+// secretRefIndexValues returns the "namespace/name" of every Secret referenced
+// by the supplied ElastiCache resource, including its connection Secret. A
+// reference without a namespace points to a Secret in the namespace of the
+// resource.
+func secretRefIndexValues(obj ctrlrtclient.Object) []string {
+	values := []string{}
+	if cfg := connectionSecret(obj); cfg != nil && cfg.Name != nil {
+		values = append(values, obj.GetNamespace()+"/"+*cfg.Name)
+	}
+	for _, ref := range secretRefs(obj) {
+		if ref == nil || ref.Name == "" {
+			continue
+		}
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = obj.GetNamespace()
+		}
+		values = append(values, namespace+"/"+ref.Name)
+	}
+	return values
+}
+
+// secretChanged only lets through updates and deletions of a Secret, so that
+// the initial listing of Secrets does not reconcile every dependent a second
+// time. Deletions let a deleted connection Secret be published again.
+var secretChanged = ctrlrtpredicate.Funcs{
+	CreateFunc: func(ctrlrtevent.CreateEvent) bool { return false },
+	DeleteFunc: func(ctrlrtevent.DeleteEvent) bool { return true },
+	UpdateFunc: func(e ctrlrtevent.UpdateEvent) bool {
+		return ctrlrtpredicate.ResourceVersionChangedPredicate{}.Update(e)
+	},
+	GenericFunc: func(ctrlrtevent.GenericEvent) bool { return false },
+}
+
+// ControllerRecorder is a controller manager that records the controllers
+// added to it by the kind of resource they reconcile, so that WatchSecrets can
+// add watches to the controllers the ACK runtime builds for each resource
+// kind.
+type ControllerRecorder struct {
+	ctrlrt.Manager
+
+	controllers map[string]ctrlrtcontroller.Controller
+}
+
+// NewControllerRecorder returns a ControllerRecorder adding controllers and
+// other runnables to mgr.
+func NewControllerRecorder(mgr ctrlrt.Manager) *ControllerRecorder {
+	return &ControllerRecorder{
+		Manager:     mgr,
+		controllers: map[string]ctrlrtcontroller.Controller{},
+	}
+}
+
+// controllerKindKey is the key of the logger value in which controller-runtime
+// records the kind of resource reconciled by the controllers it builds.
+const controllerKindKey = "controllerKind"
+
+// kindSink is a logr.LogSink remembering the controllerKindKey value of the
+// loggers derived from it.
+type kindSink struct {
+	logr.LogSink
+
+	kind string
+}
+
+func (s *kindSink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	kind := s.kind
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		if keysAndValues[i] == controllerKindKey {
+			if v, ok := keysAndValues[i+1].(string); ok {
+				kind = v
+			}
+		}
+	}
+	return &kindSink{LogSink: s.LogSink.WithValues(keysAndValues...), kind: kind}
+}
+
+func (s *kindSink) WithName(name string) logr.LogSink {
+	return &kindSink{LogSink: s.LogSink.WithName(name), kind: s.kind}
+}
+
+// GetLogger returns the logger of the manager, from which controller-runtime
+// derives the logger of the controllers it builds. The derived loggers
+// remember the kind of resource reconciled by their controller, which Add
+// reads back.
+func (m *ControllerRecorder) GetLogger() logr.Logger {
+	logger := m.Manager.GetLogger()
+	if logger.GetSink() == nil {
+		return logger
+	}
+	return logger.WithSink(&kindSink{LogSink: logger.GetSink()})
+}
+
+// Add records the controllers before adding them to the manager.
+func (m *ControllerRecorder) Add(r ctrlrtmanager.Runnable) error {
+	if c, ok := r.(ctrlrtcontroller.Controller); ok {
+		if kind := controllerKind(c); kind != "" {
+			m.controllers[kind] = c
+		}
+	}
+	return m.Manager.Add(r)
+}
+
+// controllerKind returns the kind of resource reconciled by a controller built
+// by controller-runtime from the logger of a ControllerRecorder, or an empty
+// string for other controllers.
+func controllerKind(c ctrlrtcontroller.Controller) string {
+	withLogger, ok := c.(interface{ GetLogger() logr.Logger })
+	if !ok {
+		return ""
+	}
+	if sink, ok := withLogger.GetLogger().GetSink().(*kindSink); ok {
+		return sink.kind
+	}
+	return ""
+}
+
+// WatchSecrets indexes the ElastiCache resources with is_secret fields or a
+// connection Secret by the Secrets they reference, and makes the controllers
+// of those resources watch the Secrets. When a Secret changes or is deleted,
+// the resources referencing it are queued for reconciliation by their
+// controller right away, instead of on their next periodic resync.
+//
+// The ACK runtime does not let other sources enqueue requests for its
+// controllers, so the controllers are looked up in the supplied recorder the
+// service controller was bound to. An error is returned if the controller of
+// one of the supplied reconcilers was not recorded.
+func WatchSecrets(
+	ctx context.Context,
+	recorder *ControllerRecorder,
+	reconcilers []acktypes.AWSResourceReconciler,
+) error {
+	kc := recorder.GetClient()
+	for _, reconciler := range reconcilers {
+		kind := reconciler.GroupVersionKind().Kind
+		dependent, ok := secretDependents[kind]
+		if !ok {
+			continue
+		}
+		c, ok := recorder.controllers[kind]
+		if !ok {
+			return fmt.Errorf("controller of %s was not recorded, unable to watch the Secrets it references", kind)
+		}
+		if err := recorder.GetFieldIndexer().IndexField(
+			ctx, dependent.obj, secretRefIndexField, secretRefIndexValues,
+		); err != nil {
+			return err
+		}
+		dependentsOf := func(
+			ctx context.Context,
+			secret ctrlrtclient.Object,
+		) []ctrlrtreconcile.Request {
+			list := dependent.list()
+			if err := kc.List(ctx, list, ctrlrtclient.MatchingFields{
+				secretRefIndexField: secret.GetNamespace() + "/" + secret.GetName(),
+			}); err != nil {
+				recorder.GetLogger().Error(err, "unable to list Secret dependents", "kind", kind)
+				return nil
+			}
+			objs, err := meta.ExtractList(list)
+			if err != nil {
+				return nil
+			}
+			requests := make([]ctrlrtreconcile.Request, 0, len(objs))
+			for _, obj := range objs {
+				o, ok := obj.(ctrlrtclient.Object)
+				if !ok {
+					continue
+				}
+				requests = append(requests, ctrlrtreconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Namespace: o.GetNamespace(),
+						Name:      o.GetName(),
+					},
+				})
+			}
+			return requests
+		}
+		// Only the metadata of the Secrets is cached, their data is read by
+		// the reconcilers when needed.
+		secret := &metav1.PartialObjectMetadata{}
+		secret.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+		if err := c.Watch(ctrlrtsource.Kind[ctrlrtclient.Object](
+			recorder.GetCache(),
+			secret,
+			ctrlrthandler.EnqueueRequestsFromMapFunc(dependentsOf),
+			secretChanged,
+		)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	ctrlrtcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlrtconfig "sigs.k8s.io/controller-runtime/pkg/config"
+	ctrlrtcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	ctrlrtmanager "sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlrtreconcile "sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+)
+
+func Test_secretRefIndexValues(t *testing.T) {
+	objectMeta := metav1.ObjectMeta{Namespace: "default", Name: "test"}
+	tests := []struct {
+		name string
+		obj  ctrlrtclient.Object
+		want []string
+	}{
+		{
+			name: "replication group without auth token",
+			obj:  &svcapitypes.ReplicationGroup{ObjectMeta: objectMeta},
+			want: []string{},
+		},
+		{
+			name: "replication group auth token in its namespace",
+			obj: &svcapitypes.ReplicationGroup{
+				ObjectMeta: objectMeta,
+				Spec: svcapitypes.ReplicationGroupSpec{
+					AuthToken: &ackv1alpha1.SecretKeyReference{
+						SecretReference: corev1.SecretReference{Name: "token"},
+						Key:             "token",
+					},
+				},
+			},
+			want: []string{"default/token"},
+		},
+		{
+			name: "cache cluster auth token in another namespace",
+			obj: &svcapitypes.CacheCluster{
+				ObjectMeta: objectMeta,
+				Spec: svcapitypes.CacheClusterSpec{
+					AuthToken: &ackv1alpha1.SecretKeyReference{
+						SecretReference: corev1.SecretReference{Namespace: "secrets", Name: "token"},
+						Key:             "token",
+					},
+				},
+			},
+			want: []string{"secrets/token"},
+		},
+		{
+			name: "user passwords skip references without a name",
+			obj: &svcapitypes.User{
+				ObjectMeta: objectMeta,
+				Spec: svcapitypes.UserSpec{
+					Passwords: []*ackv1alpha1.SecretKeyReference{
+						{SecretReference: corev1.SecretReference{Name: "first"}, Key: "password"},
+						nil,
+						{SecretReference: corev1.SecretReference{}, Key: "password"},
+						{SecretReference: corev1.SecretReference{Namespace: "secrets", Name: "second"}, Key: "password"},
+					},
+				},
+			},
+			want: []string{"default/first", "secrets/second"},
+		},
+		{
+			name: "serverless cache connection secret",
+			obj: &svcapitypes.ServerlessCache{
+				ObjectMeta: objectMeta,
+				Spec: svcapitypes.ServerlessCacheSpec{
+					ConnectionSecret: &svcapitypes.ConnectionSecret{Name: aws.String("conn")},
+				},
+			},
+			want: []string{"default/conn"},
+		},
+		{
+			name: "kind without secrets",
+			obj:  &svcapitypes.Snapshot{ObjectMeta: objectMeta},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := secretRefIndexValues(tt.obj); !slices.Equal(got, tt.want) {
+				t.Errorf("secretRefIndexValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ControllerRecorder(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = svcapitypes.AddToScheme(scheme)
+	recorder := NewControllerRecorder(&testManager{
+		scheme: scheme,
+		logger: funcr.New(func(string, string) {}, funcr.Options{}),
+	})
+	err := ctrlrt.NewControllerManagedBy(recorder).
+		For(&svcapitypes.ReplicationGroup{}).
+		WithOptions(ctrlrtcontroller.Options{SkipNameValidation: aws.Bool(true)}).
+		Complete(ctrlrtreconcile.Func(func(context.Context, ctrlrtreconcile.Request) (ctrlrtreconcile.Result, error) {
+			return ctrlrtreconcile.Result{}, nil
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := recorder.controllers["ReplicationGroup"]; !ok || len(recorder.controllers) != 1 {
+		t.Errorf("recorded controllers = %v, want the ReplicationGroup controller", recorder.controllers)
+	}
+}
+
+type testManager struct {
+	ctrlrt.Manager
+
+	scheme    *runtime.Scheme
+	logger    logr.Logger
+	runnables []ctrlrtmanager.Runnable
+}
+
+func (*testManager) GetClient() ctrlrtclient.Client {
+	return nil
+}
+
+func (*testManager) GetCache() ctrlrtcache.Cache {
+	return nil
+}
+
+func (m *testManager) GetScheme() *runtime.Scheme {
+	return m.scheme
+}
+
+func (m *testManager) GetLogger() logr.Logger {
+	return m.logger
+}
+
+func (*testManager) GetControllerOptions() ctrlrtconfig.Controller {
+	return ctrlrtconfig.Controller{}
+}
+
+func (m *testManager) Add(r ctrlrtmanager.Runnable) error {
+	m.runnables = append(m.runnables, r)
+	return nil
+}
+
+type testReconciler struct {
+	acktypes.AWSResourceReconciler
+	kind string
+}
+
+func (r testReconciler) GroupVersionKind() *schema.GroupVersionKind {
+	gvk := svcapitypes.GroupVersion.WithKind(r.kind)
+	return &gvk
+}
+
+func Test_WatchSecrets_controllerNotRecorded(t *testing.T) {
+	recorder := NewControllerRecorder(&testManager{})
+	reconcilers := []acktypes.AWSResourceReconciler{
+		testReconciler{kind: "Snapshot"},
+		testReconciler{kind: "ReplicationGroup"},
+	}
+	if err := WatchSecrets(context.Background(), recorder, reconcilers); err == nil {
+		t.Error("WatchSecrets() error = nil, want an error for the unrecorded ReplicationGroup controller")
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/elasticache-controller/apis/v1alpha1"
+)
+
+const week = 7 * 24 * time.Hour
+
+var requeueWaitWhileServiceUpdateChanged = ackrequeue.NeededAfter(
+	errors.New("service update action is in progress"),
+	ackrequeue.DefaultRequeueAfterDuration,
+)
+
+// pendingUpdateActionStatuses are the update action statuses reported in
+// Status.PendingUpdateActions. Completed and not applicable actions are left
+// out.
+var pendingUpdateActionStatuses = []svcsdktypes.UpdateActionStatus{
+	svcsdktypes.UpdateActionStatusNotApplied,
+	svcsdktypes.UpdateActionStatusWaitingToStart,
+	svcsdktypes.UpdateActionStatusInProgress,
+	svcsdktypes.UpdateActionStatusStopping,
+	svcsdktypes.UpdateActionStatusStopped,
+	svcsdktypes.UpdateActionStatusScheduling,
+	svcsdktypes.UpdateActionStatusScheduled,
+}
+
+var weekdays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// GetPendingUpdateActions returns the service update actions that have not
+// been applied yet to the supplied replication group or cache cluster. All
+// pending actions are returned, whether or not the resource names a service
+// update to apply, so that pending security and engine updates are reported.
+func GetPendingUpdateActions(
+	ctx context.Context,
+	sdkapi *svcsdk.Client,
+	metrics *metrics.Metrics,
+	replicationGroupID *string,
+	cacheClusterID *string,
+) ([]*svcapitypes.UpdateAction, error) {
+	input := &svcsdk.DescribeUpdateActionsInput{
+		ServiceUpdateStatus: []svcsdktypes.ServiceUpdateStatus{
+			svcsdktypes.ServiceUpdateStatusAvailable,
+		},
+		UpdateActionStatus: pendingUpdateActionStatuses,
+	}
+	if replicationGroupID != nil {
+		input.ReplicationGroupIds = []string{*replicationGroupID}
+	}
+	if cacheClusterID != nil {
+		input.CacheClusterIds = []string{*cacheClusterID}
+	}
+
+	actions := []*svcapitypes.UpdateAction{}
+	for {
+		resp, err := sdkapi.DescribeUpdateActions(ctx, input)
+		metrics.RecordAPICall("READ_MANY", "DescribeUpdateActions", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range resp.UpdateActions {
+			actions = append(actions, newUpdateAction(a))
+		}
+		if resp.Marker == nil || *resp.Marker == "" {
+			break
+		}
+		input.Marker = resp.Marker
+	}
+	return actions, nil
+}
+
+func newUpdateAction(a svcsdktypes.UpdateAction) *svcapitypes.UpdateAction {
+	action := &svcapitypes.UpdateAction{
+		CacheClusterID:        a.CacheClusterId,
+		Engine:                a.Engine,
+		EstimatedUpdateTime:   a.EstimatedUpdateTime,
+		NodesUpdated:          a.NodesUpdated,
+		ReplicationGroupID:    a.ReplicationGroupId,
+		ServiceUpdateName:     a.ServiceUpdateName,
+		ServiceUpdateSeverity: aws.String(string(a.ServiceUpdateSeverity)),
+		ServiceUpdateStatus:   aws.String(string(a.ServiceUpdateStatus)),
+		ServiceUpdateType:     aws.String(string(a.ServiceUpdateType)),
+		SlaMet:                aws.String(string(a.SlaMet)),
+		UpdateActionStatus:    aws.String(string(a.UpdateActionStatus)),
+	}
+	if a.ServiceUpdateRecommendedApplyByDate != nil {
+		action.ServiceUpdateRecommendedApplyByDate = &metav1.Time{Time: *a.ServiceUpdateRecommendedApplyByDate}
+	}
+	if a.ServiceUpdateReleaseDate != nil {
+		action.ServiceUpdateReleaseDate = &metav1.Time{Time: *a.ServiceUpdateReleaseDate}
+	}
+	if a.UpdateActionAvailableDate != nil {
+		action.UpdateActionAvailableDate = &metav1.Time{Time: *a.UpdateActionAvailableDate}
+	}
+	if a.UpdateActionStatusModifiedDate != nil {
+		action.UpdateActionStatusModifiedDate = &metav1.Time{Time: *a.UpdateActionStatusModifiedDate}
+	}
+	return action
+}
+
+// ServiceUpdateActionRequired returns true if the named service update is
+// waiting to be applied, or is running outside of the supplied window and
+// needs to be stopped.
+func ServiceUpdateActionRequired(
+	serviceUpdateName *string,
+	window *string,
+	actions []*svcapitypes.UpdateAction,
+) bool {
+	action := findUpdateAction(serviceUpdateName, actions)
+	if action == nil {
+		return false
+	}
+	if canApplyUpdateAction(action) {
+		return true
+	}
+	open, _, err := ServiceUpdateWindowOpen(window, time.Now())
+	return err != nil || (!open && canStopUpdateAction(action))
+}
+
+// SyncServiceUpdate applies the named service update to the supplied
+// replication group or cache cluster while the window is open, and stops it
+// when it is still running after the window has closed. A requeue error is
+// returned while the window is closed or after the update action changed.
+func SyncServiceUpdate(
+	ctx context.Context,
+	sdkapi *svcsdk.Client,
+	metrics *metrics.Metrics,
+	serviceUpdateName *string,
+	window *string,
+	actions []*svcapitypes.UpdateAction,
+	replicationGroupID *string,
+	cacheClusterID *string,
+) error {
+	action := findUpdateAction(serviceUpdateName, actions)
+	if action == nil {
+		return nil
+	}
+	open, untilOpen, err := ServiceUpdateWindowOpen(window, time.Now())
+	if err != nil {
+		return ackerr.NewTerminalError(err)
+	}
+
+	var replicationGroupIDs, cacheClusterIDs []string
+	if replicationGroupID != nil {
+		replicationGroupIDs = []string{*replicationGroupID}
+	}
+	if cacheClusterID != nil {
+		cacheClusterIDs = []string{*cacheClusterID}
+	}
+
+	switch {
+	case canApplyUpdateAction(action) && !open:
+		return ackrequeue.NeededAfter(
+			fmt.Errorf("waiting for service update window %s to apply %s", *window, *serviceUpdateName),
+			untilOpen,
+		)
+	case canApplyUpdateAction(action):
+		resp, err := sdkapi.BatchApplyUpdateAction(ctx, &svcsdk.BatchApplyUpdateActionInput{
+			ServiceUpdateName:   serviceUpdateName,
+			ReplicationGroupIds: replicationGroupIDs,
+			CacheClusterIds:     cacheClusterIDs,
+		})
+		metrics.RecordAPICall("UPDATE", "BatchApplyUpdateAction", err)
+		if err != nil {
+			return err
+		}
+		if err := unprocessedUpdateActionsError(resp.UnprocessedUpdateActions); err != nil {
+			return err
+		}
+	case canStopUpdateAction(action) && !open:
+		resp, err := sdkapi.BatchStopUpdateAction(ctx, &svcsdk.BatchStopUpdateActionInput{
+			ServiceUpdateName:   serviceUpdateName,
+			ReplicationGroupIds: replicationGroupIDs,
+			CacheClusterIds:     cacheClusterIDs,
+		})
+		metrics.RecordAPICall("UPDATE", "BatchStopUpdateAction", err)
+		if err != nil {
+			return err
+		}
+		if err := unprocessedUpdateActionsError(resp.UnprocessedUpdateActions); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+	return requeueWaitWhileServiceUpdateChanged
+}
+
+// ServiceUpdateWindowOpen returns true if now is within the supplied weekly
+// window, in the ddd:hh24:mi-ddd:hh24:mi format used by maintenance windows,
+// and otherwise how long it takes until the window opens. A nil window is
+// always open.
+func ServiceUpdateWindowOpen(window *string, now time.Time) (bool, time.Duration, error) {
+	if window == nil {
+		return true, 0, nil
+	}
+	bounds := strings.Split(*window, "-")
+	if len(bounds) != 2 {
+		return false, 0, fmt.Errorf("invalid service update window %q, expected ddd:hh24:mi-ddd:hh24:mi", *window)
+	}
+	start, err := weeklyOffset(bounds[0])
+	if err != nil {
+		return false, 0, fmt.Errorf("invalid service update window %q: %v", *window, err)
+	}
+	end, err := weeklyOffset(bounds[1])
+	if err != nil {
+		return false, 0, fmt.Errorf("invalid service update window %q: %v", *window, err)
+	}
+
+	now = now.UTC()
+	current := time.Duration(now.Weekday())*24*time.Hour +
+		time.Duration(now.Hour())*time.Hour +
+		time.Duration(now.Minute())*time.Minute +
+		time.Duration(now.Second())*time.Second
+	var open bool
+	if start < end {
+		open = current >= start && current < end
+	} else {
+		// the window wraps around the end of the week
+		open = current >= start || current < end
+	}
+	if open {
+		return true, 0, nil
+	}
+	return false, (start - current + week) % week, nil
+}
+
+// weeklyOffset parses a ddd:hh24:mi value into the offset from the start of
+// the week (Sunday 00:00 UTC).
+func weeklyOffset(value string) (time.Duration, error) {
+	parts := strings.Split(strings.ToLower(strings.TrimSpace(value)), ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("%q is not in ddd:hh24:mi format", value)
+	}
+	day := -1
+	for i, d := range weekdays {
+		if parts[0] == d {
+			day = i
+			break
+		}
+	}
+	hour, hourErr := strconv.Atoi(parts[1])
+	minute, minuteErr := strconv.Atoi(parts[2])
+	if day < 0 || hourErr != nil || minuteErr != nil || hour < 0 || hour > 23 || minute < 0 || minute > 59 {
+		return 0, fmt.Errorf("%q is not in ddd:hh24:mi format", value)
+	}
+	return time.Duration(day)*24*time.Hour +
+		time.Duration(hour)*time.Hour +
+		time.Duration(minute)*time.Minute, nil
+}
+
+func findUpdateAction(
+	serviceUpdateName *string,
+	actions []*svcapitypes.UpdateAction,
+) *svcapitypes.UpdateAction {
+	if serviceUpdateName == nil {
+		return nil
+	}
+	for _, a := range actions {
+		if a.ServiceUpdateName != nil && *a.ServiceUpdateName == *serviceUpdateName {
+			return a
+		}
+	}
+	return nil
+}
+
+func canApplyUpdateAction(a *svcapitypes.UpdateAction) bool {
+	switch svcsdktypes.UpdateActionStatus(aws.ToString(a.UpdateActionStatus)) {
+	case svcsdktypes.UpdateActionStatusNotApplied,
+		svcsdktypes.UpdateActionStatusStopped:
+		return true
+	}
+	return false
+}
+
+func canStopUpdateAction(a *svcapitypes.UpdateAction) bool {
+	switch svcsdktypes.UpdateActionStatus(aws.ToString(a.UpdateActionStatus)) {
+	case svcsdktypes.UpdateActionStatusWaitingToStart,
+		svcsdktypes.UpdateActionStatusInProgress,
+		svcsdktypes.UpdateActionStatusScheduling,
+		svcsdktypes.UpdateActionStatusScheduled:
+		return true
+	}
+	return false
+}
+
+func unprocessedUpdateActionsError(unprocessed []svcsdktypes.UnprocessedUpdateAction) error {
+	if len(unprocessed) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(unprocessed))
+	for _, u := range unprocessed {
+		messages = append(messages, fmt.Sprintf("%s: %s",
+			aws.ToString(u.ErrorType), aws.ToString(u.ErrorMessage)))
+	}
+	return fmt.Errorf("service update action was not processed: %s", strings.Join(messages, ", "))
+}
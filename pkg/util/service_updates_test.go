@@ -0,0 +1,145 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func Test_weeklyOffset(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "sun:00:00", want: 0},
+		{value: "mon:01:30", want: 25*time.Hour + 30*time.Minute},
+		{value: "sat:23:59", want: 6*24*time.Hour + 23*time.Hour + 59*time.Minute},
+		{value: " Wed:12:00 ", want: 3*24*time.Hour + 12*time.Hour},
+		{value: "sun:24:00", wantErr: true},
+		{value: "sun:00:60", wantErr: true},
+		{value: "sunday:00:00", wantErr: true},
+		{value: "sun:00", wantErr: true},
+		{value: "sun:aa:00", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := weeklyOffset(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("weeklyOffset(%q) error = %v, wantErr %t", tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("weeklyOffset(%q) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func Test_ServiceUpdateWindowOpen(t *testing.T) {
+	// 2024-01-07 is a Sunday.
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 1, 7+day, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name          string
+		window        *string
+		now           time.Time
+		wantOpen      bool
+		wantUntilOpen time.Duration
+		wantErr       bool
+	}{
+		{
+			name:     "no window",
+			now:      at(2, 10, 0),
+			wantOpen: true,
+		},
+		{
+			name:     "within window",
+			window:   aws.String("tue:09:00-tue:11:00"),
+			now:      at(2, 10, 0),
+			wantOpen: true,
+		},
+		{
+			name:          "at window end",
+			window:        aws.String("tue:09:00-tue:11:00"),
+			now:           at(2, 11, 0),
+			wantUntilOpen: 7*24*time.Hour - 2*time.Hour,
+		},
+		{
+			name:          "before window",
+			window:        aws.String("tue:09:00-tue:11:00"),
+			now:           at(1, 9, 0),
+			wantUntilOpen: 24 * time.Hour,
+		},
+		{
+			name:     "sunday to monday window on sunday",
+			window:   aws.String("sun:23:00-mon:01:30"),
+			now:      at(0, 23, 30),
+			wantOpen: true,
+		},
+		{
+			name:     "sunday to monday window on monday",
+			window:   aws.String("sun:23:00-mon:01:30"),
+			now:      at(1, 1, 0),
+			wantOpen: true,
+		},
+		{
+			name:          "sunday to monday window after it closed",
+			window:        aws.String("sun:23:00-mon:01:30"),
+			now:           at(1, 1, 30),
+			wantUntilOpen: 6*24*time.Hour + 21*time.Hour + 30*time.Minute,
+		},
+		{
+			name:     "saturday to sunday window across the end of the week",
+			window:   aws.String("sat:22:00-sun:02:00"),
+			now:      at(0, 1, 0),
+			wantOpen: true,
+		},
+		{
+			name:          "saturday to sunday window before it opens",
+			window:        aws.String("sat:22:00-sun:02:00"),
+			now:           at(6, 21, 0),
+			wantUntilOpen: time.Hour,
+		},
+		{
+			name:     "non-UTC time",
+			window:   aws.String("tue:09:00-tue:11:00"),
+			now:      at(2, 10, 0).In(time.FixedZone("UTC+5", 5*60*60)),
+			wantOpen: true,
+		},
+		{
+			name:    "missing end",
+			window:  aws.String("tue:09:00"),
+			wantErr: true,
+		},
+		{
+			name:    "invalid day",
+			window:  aws.String("tue:09:00-xyz:11:00"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			open, untilOpen, err := ServiceUpdateWindowOpen(tt.window, tt.now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ServiceUpdateWindowOpen() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if open != tt.wantOpen || untilOpen != tt.wantUntilOpen {
+				t.Errorf("ServiceUpdateWindowOpen() = %t, %s, want %t, %s", open, untilOpen, tt.wantOpen, tt.wantUntilOpen)
+			}
+		})
+	}
+}
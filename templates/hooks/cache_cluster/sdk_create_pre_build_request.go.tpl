@@ -0,0 +1,3 @@
+	if err = validateFinalSnapshot(desired); err != nil {
+		return nil, err
+	}
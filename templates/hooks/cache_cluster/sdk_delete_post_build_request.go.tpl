@@ -0,0 +1 @@
+	input.FinalSnapshotIdentifier = finalSnapshotIdentifier(r)
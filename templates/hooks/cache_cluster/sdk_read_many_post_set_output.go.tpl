@@ -5,6 +5,9 @@
 		if pendingModifications.CacheNodeType != nil {
 			ko.Spec.CacheNodeType = pendingModifications.CacheNodeType
 		}
+		if pendingModifications.EngineVersion != nil {
+			ko.Spec.EngineVersion = pendingModifications.EngineVersion
+		}
 		if pendingModifications.TransitEncryptionEnabled != nil {
 			ko.Spec.TransitEncryptionEnabled = pendingModifications.TransitEncryptionEnabled
 		}
@@ -21,6 +24,19 @@
 		ko.Spec.SecurityGroupIDs = nil
 	}
 
+	if err := rm.setPendingUpdateActions(ctx, ko); err != nil {
+		return nil, err
+	}
+
+	rm.setObservedAuthToken(ctx, r, ko)
+	rm.recordEvents(ctx, ko)
+	util.ForgetDeletedConnectionSecret(ctx, ko, ko.Spec.ConnectionSecret)
+	endTransitionIfAvailable(&resource{ko})
+
+	if err := validateFinalSnapshot(&resource{ko}); err != nil {
+		ackcondition.SetTerminal(&resource{ko}, corev1.ConditionTrue, &condMsgNoMemcachedSnapshot, nil)
+	}
+
 	if isAvailable(r) {
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionTrue, nil, nil)
 	} else {
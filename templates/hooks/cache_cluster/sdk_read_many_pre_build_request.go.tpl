@@ -0,0 +1,4 @@
+	if err := rm.requeueWhileThrottled(); err != nil {
+		return nil, err
+	}
+	ctx = rm.describeFromCache(ctx, r)
@@ -5,6 +5,9 @@
 		if pendingModifications.CacheNodeType != nil {
 			ko.Spec.CacheNodeType = pendingModifications.CacheNodeType
 		}
+		if pendingModifications.EngineVersion != nil {
+			ko.Spec.EngineVersion = pendingModifications.EngineVersion
+		}
 		if pendingModifications.TransitEncryptionEnabled != nil {
 			ko.Spec.TransitEncryptionEnabled = pendingModifications.TransitEncryptionEnabled
 		}
@@ -0,0 +1,13 @@
+	if isDeleting(r) {
+		return r, requeueWaitWhileDeleting
+	}
+	if isCreating(r) || isModifying(r) {
+		return r, requeueWaitUntilCanModify(r)
+	}
+	// Secondary replication groups must be disassociated before the Global
+	// datastore can be deleted.
+	if hasSecondaries, err := rm.disassociateSecondaryMembers(ctx, r, nil); err != nil {
+		return nil, err
+	} else if hasSecondaries {
+		return r, requeueWaitWhileDisassociating
+	}
@@ -0,0 +1,2 @@
+	// Member information is needed to manage secondary replication groups
+	input.ShowMemberInfo = aws.Bool(true)
@@ -0,0 +1,18 @@
+	setCurrentPrimaryReplicationGroupID(ko)
+	if isDeleting(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(
+			&resource{ko},
+			corev1.ConditionFalse,
+			&condMsgCurrentlyDeleting,
+			nil,
+		)
+	} else if msg := failoverProgressMessage(ko.Spec.PrimaryRegion, &resource{ko}); msg != "" {
+		ackcondition.SetSynced(
+			&resource{ko},
+			corev1.ConditionFalse,
+			&msg,
+			nil,
+		)
+	}
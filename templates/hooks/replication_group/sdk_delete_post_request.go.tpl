@@ -14,5 +14,5 @@
 		// - reconciler.HandleReconcileError() does not update status for unmanaged resource
 		// - reconciler.handleRequeues() is not invoked for delete code path.
 		// TODO: return err as nil when reconciler is updated.
-		return rp, requeueWaitWhileDeleting
+		return rp, rm.requeueWaitWhileDeleting(r)
     }
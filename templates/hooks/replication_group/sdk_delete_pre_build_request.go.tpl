@@ -12,7 +12,7 @@
 		// - reconciler.HandleReconcileError() does not update status for unmanaged resource
 		// - reconciler.handleRequeues() is not invoked for delete code path.
 		// TODO: return err as nil when reconciler is updated.
-		return r, requeueWaitWhileDeleting
+		return r, rm.requeueWaitWhileDeleting(r)
 	}
 	if isModifying(r) {
 		// Setting resource synced condition to false will trigger a requeue of
@@ -28,5 +28,8 @@
 		// - reconciler.HandleReconcileError() does not update status for unmanaged resource
 		// - reconciler.handleRequeues() is not invoked for delete code path.
 		// TODO: return err as nil when reconciler is updated.
-		return r, requeueWaitWhileModifying
+		return r, rm.requeueWaitWhileModifying(r)
+	}
+	if err := util.RequeueIfDeletionProtected(r); err != nil {
+		return r, err
 	}
@@ -48,6 +48,22 @@
 		return &resource{ko}, nil
 	}
 
+	joining, err := rm.joiningGlobalReplicationGroup(ctx, &resource{ko})
+	if err != nil {
+		return nil, err
+	}
+	if joining {
+		// Membership is reported in Status.GlobalReplicationGroupInfo once the
+		// Global datastore has associated this replication group. Keep the
+		// resource out of sync so it is requeued until then.
+		ackcondition.SetSynced(
+			&resource{ko},
+			corev1.ConditionFalse,
+			&condMsgJoiningGlobalReplicationGroup,
+			nil,
+		)
+	}
+
 	if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN != nil {
         resourceARN := (*string)(ko.Status.ACKResourceMetadata.ARN)
         tags, err := rm.getTags(ctx, *resourceARN)
@@ -0,0 +1,7 @@
+	if err := rm.requeueWhileThrottled(); err != nil {
+		return nil, err
+	}
+	if err := rm.adoptFinalSnapshot(ctx, r); err != nil {
+		return nil, err
+	}
+	ctx = rm.describeFromCache(ctx, r)
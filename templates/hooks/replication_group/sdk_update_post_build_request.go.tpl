@@ -1,9 +1,18 @@
+	input.ApplyImmediately = applyImmediately(desired)
 	if !delta.DifferentAt("Spec.LogDeliveryConfigurations") {
 		input.LogDeliveryConfigurations = nil
 	}
-	if !delta.DifferentAt("Spec.TransitEncryptionEnabled") {
-		input.TransitEncryptionEnabled = nil
-	}
+	// AUTH token changes are passed to ElastiCache by updateAuthToken, along
+	// with their update strategy.
+	input.AuthToken = nil
+	input.AuthTokenUpdateStrategy = ""
+	// In-transit encryption changes are applied one step at a time by
+	// migrateTransitEncryption.
+	input.TransitEncryptionEnabled = nil
+	input.TransitEncryptionMode = ""
+	// Cluster mode changes are applied one step at a time by
+	// migrateClusterMode.
+	input.ClusterMode = ""
 	if !delta.DifferentAt("Spec.Durability") {
 		input.Durability = ""
 	}
@@ -1,5 +1,26 @@
+	defer rm.invalidateDescribeCache(desired)
+
+	done, err := util.SyncConnectionSecret(
+		ctx, delta, desired.ko, desired.ko.Spec.ConnectionSecret,
+		func() util.ConnectionDetails { return connectionDetails(desired, latest) },
+		rm.rr.SecretValueFromReference,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if done {
+		// The connection Secret was the only difference.
+		return desired, nil
+	}
 	if delta.DifferentAt("Spec.Tags") {
 		if err = rm.syncTags(ctx, desired, latest); err != nil {
 			return nil, err
 		}
 	}
+	if delta.DifferentAt("Spec.ServiceUpdateName") && !delta.DifferentExcept(append(nonBlockingDifferences(desired, latest), "Spec.ServiceUpdateName")...) {
+		// Service updates are applied once every other difference is resolved.
+		if err = rm.syncServiceUpdate(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		return desired, nil
+	}
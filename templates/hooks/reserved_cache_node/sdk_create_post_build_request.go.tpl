@@ -0,0 +1,7 @@
+	if input.ReservedCacheNodesOfferingId == nil {
+		offeringID, err := rm.findReservedCacheNodesOfferingID(ctx, desired)
+		if err != nil {
+			return nil, err
+		}
+		input.ReservedCacheNodesOfferingId = offeringID
+	}
@@ -0,0 +1 @@
+	setReservationARN(ko, resp.ReservedCacheNode.ReservationARN)
@@ -0,0 +1,11 @@
+	// DescribeReservedCacheNodes is filtered on ReservedCacheNodeId, so the
+	// first reservation is the one that was set above.
+	setReservationARN(ko, resp.ReservedCacheNodes[0].ReservationARN)
+	if ko.Status.ACKResourceMetadata.ARN != nil {
+		resourceARN := (*string)(ko.Status.ACKResourceMetadata.ARN)
+		tags, err := rm.getTags(ctx, *resourceARN)
+		if err != nil {
+			return nil, err
+		}
+		ko.Spec.Tags = tags
+	}
@@ -0,0 +1 @@
+	input.SnapshotArnsToRestore = append(input.SnapshotArnsToRestore, aws.ToStringSlice(desired.ko.Spec.NodeSnapshotARNsToRestore)...)
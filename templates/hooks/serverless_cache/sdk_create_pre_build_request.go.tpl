@@ -0,0 +1,3 @@
+	if err := requeueUntilSnapshotsAvailable(ctx, desired); err != nil {
+		return nil, err
+	}
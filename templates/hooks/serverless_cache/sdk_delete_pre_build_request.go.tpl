@@ -0,0 +1,3 @@
+	if err := util.RequeueIfDeletionProtected(r); err != nil {
+		return r, err
+	}
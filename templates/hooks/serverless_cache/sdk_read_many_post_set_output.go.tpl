@@ -6,4 +6,7 @@ if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN !=
     if err == nil {
         ko.Spec.Tags = tags
     }
-}
\ No newline at end of file
+}
+rm.recordEvents(ctx, ko)
+util.ForgetDeletedConnectionSecret(ctx, ko, ko.Spec.ConnectionSecret)
+endTransitionIfAvailable(&resource{ko})
\ No newline at end of file
@@ -8,4 +8,5 @@
             return nil, err
         }
         ko.Spec.Tags = tags
-    }
\ No newline at end of file
+    }
+    setExportStatus(ko)
\ No newline at end of file
@@ -0,0 +1,3 @@
+	if err = validateAccessString(desired); err != nil {
+		return nil, err
+	}
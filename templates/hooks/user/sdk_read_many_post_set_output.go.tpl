@@ -5,3 +5,5 @@
 			ko.Spec.AuthenticationMode.Type = &authType
 		}
 	}
+	rm.setObservedPasswords(ctx, ko)
+	rm.recordEvents(ctx, ko)
@@ -0,0 +1,3 @@
+	if err := rm.requeueWhileThrottled(); err != nil {
+		return nil, err
+	}
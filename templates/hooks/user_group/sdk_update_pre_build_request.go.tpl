@@ -1,3 +1,3 @@
   if !isActive(latest.ko) {
-		return nil, requeueWaitUntilCanModify(latest)
+		return nil, rm.requeueWaitUntilCanModify(latest)
 	}
\ No newline at end of file